@@ -0,0 +1,148 @@
+package analytics
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Calculation is an analytic that Alpha Vantage calculates over the returns of each symbol
+type Calculation int
+
+const (
+	UnknownCalculation Calculation = iota
+	Mean
+	Median
+	CumulativeReturn
+	Variance
+	StdDev
+	MaxDrawdown
+	Histogram
+	Autocorrelation
+	Covariance
+	Correlation
+	InvalidCalculation
+)
+
+func (c Calculation) String() string {
+	switch c {
+	case Mean:
+		return "MEAN"
+	case Median:
+		return "MEDIAN"
+	case CumulativeReturn:
+		return "CUMULATIVE_RETURN"
+	case Variance:
+		return "VARIANCE"
+	case StdDev:
+		return "STDDEV"
+	case MaxDrawdown:
+		return "MAX_DRAWDOWN"
+	case Histogram:
+		return "HISTOGRAM"
+	case Autocorrelation:
+		return "AUTOCORRELATION"
+	case Covariance:
+		return "COVARIANCE"
+	case Correlation:
+		return "CORRELATION"
+	default:
+		panic("invalid value of Calculation")
+	}
+}
+
+func (c Calculation) isValid() bool {
+	if c <= UnknownCalculation || c >= InvalidCalculation {
+		return false
+	}
+	return true
+}
+
+// isPairwise is true for calculations that return a matrix across the symbols
+func (c Calculation) isPairwise() bool {
+	return c == Covariance || c == Correlation
+}
+
+// supportsSliding is true for calculations available from ANALYTICS_SLIDING_WINDOW
+func (c Calculation) supportsSliding() bool {
+	switch c {
+	case Mean, Median, CumulativeReturn, Variance, StdDev, Covariance, Correlation:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseCalculation returns the Calculation from a returned key, such as "STDDEV(ANNUALIZED=TRUE)"
+func parseCalculation(s string) (Calculation, error) {
+	name, _, _ := strings.Cut(strings.ToUpper(s), "(")
+	for c := UnknownCalculation + 1; c < InvalidCalculation; c++ {
+		if c.String() == name {
+			return c, nil
+		}
+	}
+	return UnknownCalculation, fmt.Errorf("unknown calculation %s", s)
+}
+
+// CorrelationMethod is the method used by the Correlation calculation
+type CorrelationMethod int
+
+const (
+	UnknownCorrelationMethod CorrelationMethod = iota
+	Pearson
+	Kendall
+	Spearman
+	InvalidCorrelationMethod
+)
+
+func (m CorrelationMethod) String() string {
+	switch m {
+	case Pearson:
+		return "PEARSON"
+	case Kendall:
+		return "KENDALL"
+	case Spearman:
+		return "SPEARMAN"
+	default:
+		panic("invalid value of CorrelationMethod")
+	}
+}
+
+// CalculationSpec describes a requested Calculation, together with its optional parameters
+type CalculationSpec struct {
+	// Calculation is the analytic to be calculated
+	Calculation Calculation
+	// Annualized applies to Variance and StdDev
+	Annualized bool
+	// Bins is the number of bins of a Histogram.  Default: 10
+	Bins int
+	// Lag is the lag of an Autocorrelation.  Default: 1
+	Lag int
+	// Method applies to Correlation.  Default: Pearson
+	Method CorrelationMethod
+}
+
+// String returns the specification in the form expected by Alpha Vantage, e.g. "STDDEV(annualized=True)"
+func (s CalculationSpec) String() string {
+	switch {
+	case s.Annualized && (s.Calculation == Variance || s.Calculation == StdDev):
+		return fmt.Sprintf("%s(annualized=True)", s.Calculation)
+	case s.Bins > 0 && s.Calculation == Histogram:
+		return fmt.Sprintf("%s(bins=%d)", s.Calculation, s.Bins)
+	case s.Lag > 0 && s.Calculation == Autocorrelation:
+		return fmt.Sprintf("%s(lag=%d)", s.Calculation, s.Lag)
+	case s.Method != UnknownCorrelationMethod && s.Calculation == Correlation:
+		return fmt.Sprintf("%s(method=%s)", s.Calculation, s.Method)
+	default:
+		return s.Calculation.String()
+	}
+}
+
+func (s CalculationSpec) isValid() bool {
+	if !s.Calculation.isValid() || s.Bins < 0 || s.Lag < 0 {
+		return false
+	}
+	if s.Method < UnknownCorrelationMethod || s.Method >= InvalidCorrelationMethod {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package analytics
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/historic"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Symbols are the symbols included in the calculations
+	Symbols []string
+	// Start is the date of the earliest return used in the calculations
+	Start time.Time
+	// End is the date of the latest return used in the calculations
+	End time.Time
+	// OHLC is the price used to calculate returns
+	OHLC historic.InformationType
+	// Frequency is the spacing of the returns
+	Frequency common.Frequency
+	// Interval is the spacing of the returns when Frequency is Intraday
+	Interval intraday.Interval
+	// WindowSize is the number of returns in each window of a sliding window calculation
+	WindowSize int
+}
+
+// Drawdown is the maximum drawdown of a symbol
+type Drawdown struct {
+	// MaxDrawdown is the largest peak to trough decline, as a fraction (e.g. -0.1 is a 10% decline)
+	MaxDrawdown float64
+	// Start is the date of the peak
+	Start time.Time
+	// End is the date of the trough
+	End time.Time
+}
+
+// HistogramBins is the distribution of the returns of a symbol
+type HistogramBins struct {
+	// Counts is the number of returns in each bin
+	Counts []int
+	// Edges are the boundaries of the bins, so that there is one more edge than count
+	Edges []float64
+}
+
+// Matrix holds a pairwise calculation, such as Correlation, across the symbols
+type Matrix struct {
+	// Symbols is the order of the rows and columns of Values
+	Symbols []string
+	// Values is the symmetric matrix of results
+	Values [][]float64
+}
+
+// Get returns the value for the pair of symbols
+func (m *Matrix) Get(a, b string) (float64, bool) {
+	i := slices.Index(m.Symbols, a)
+	j := slices.Index(m.Symbols, b)
+	if i < 0 || j < 0 {
+		return 0, false
+	}
+	return m.Values[i][j], true
+}
+
+// FixedWindowData is the returned object from a call to GetFixedWindow
+type FixedWindowData struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// Values holds the results of calculations that return a single value per symbol, keyed by symbol
+	Values map[Calculation]map[string]float64
+	// Drawdowns holds the results of MaxDrawdown, keyed by symbol
+	Drawdowns map[string]*Drawdown
+	// Histograms holds the results of Histogram, keyed by symbol
+	Histograms map[string]*HistogramBins
+	// Matrices holds the results of pairwise calculations
+	Matrices map[Calculation]*Matrix
+}
+
+// WindowedResult returns the single value calculations for the symbol in the form returned by
+// historic.GetWindowedCalculation, keyed by the Calculation name, so that they can be compared
+// with local calculations.  Each time series has a single element, as of the end of the data range.
+func (d *FixedWindowData) WindowedResult(symbol string) *historic.WindowedResult {
+	result := &historic.WindowedResult{
+		Meta: &historic.WindowedMeta{
+			InformationType: d.Meta.OHLC,
+		},
+		TimeSeries: map[string][]*historic.WindowedElement{},
+	}
+	for c, m := range d.Values {
+		if v, ok := m[symbol]; ok {
+			result.TimeSeries[c.String()] = []*historic.WindowedElement{
+				{
+					WindowStart: d.Meta.End,
+					Value:       v,
+				},
+			}
+		}
+	}
+	return result
+}
+
+// SlidingWindowData is the returned object from a call to GetSlidingWindow
+type SlidingWindowData struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// Series holds the running results of each calculation, keyed by symbol, or by
+	// "SYMBOL1-SYMBOL2" for pairwise calculations.  Each series is ordered most recent first.
+	Series map[Calculation]map[string][]*historic.WindowedElement
+}
+
+// WindowedResult returns the running calculations for the symbol (or pair of symbols) in the form
+// returned by historic.GetWindowedCalculation, keyed by the Calculation name, so that they can be
+// compared with local calculations using the same window length.
+func (d *SlidingWindowData) WindowedResult(symbol string) *historic.WindowedResult {
+	result := &historic.WindowedResult{
+		Meta: &historic.WindowedMeta{
+			WindowLength:    d.Meta.WindowSize,
+			InformationType: d.Meta.OHLC,
+		},
+		TimeSeries: map[string][]*historic.WindowedElement{},
+	}
+	for c, m := range d.Series {
+		if s, ok := m[symbol]; ok {
+			result.TimeSeries[c.String()] = s
+		}
+	}
+	return result
+}
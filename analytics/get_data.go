@@ -0,0 +1,441 @@
+package analytics
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/historic"
+)
+
+// respJSON captures all possible return JSON
+type respJSON struct {
+	Info    *string          `json:"Information"`
+	Err     *string          `json:"Error Message"`
+	Meta    *respMetaJSON    `json:"meta_data"`
+	Payload *respPayloadJSON `json:"payload"`
+}
+
+type respMetaJSON struct {
+	Symbols string `json:"symbols"`
+	MinDate string `json:"min_dt"`
+	MaxDate string `json:"max_dt"`
+}
+
+type respPayloadJSON struct {
+	Calculations map[string]json.RawMessage `json:"RETURNS_CALCULATIONS"`
+}
+
+type respDrawdownJSON struct {
+	MaxDrawdown float64 `json:"max_drawdown"`
+	Range       struct {
+		Start string `json:"start_drawdown"`
+		End   string `json:"end_drawdown"`
+	} `json:"drawdown_range"`
+}
+
+type respHistogramJSON struct {
+	Counts []int     `json:"bin_count"`
+	Edges  []float64 `json:"bin_edges"`
+}
+
+// ErrNoSymbols returned when no symbols are specified
+var ErrNoSymbols = errors.New("at least one symbol must be specified")
+
+// ErrInvalidCalculation returned when a calculation is invalid or is not supported by the endpoint
+var ErrInvalidCalculation = errors.New("invalid calculation specified")
+
+// minWindowSize is the smallest window supported by ANALYTICS_SLIDING_WINDOW
+const minWindowSize = 10
+
+// Endpoints of ANALYTICS_FIXED_WINDOW and ANALYTICS_SLIDING_WINDOW
+const (
+	fixedWindowURL   = "https://alphavantageapi.co/timeseries/analytics"
+	slidingWindowURL = "https://alphavantageapi.co/timeseries/running_analytics"
+)
+
+// GetFixedWindow uses the provided apiKey to retrieve the calculations over the returns of the symbols for the whole data range
+func GetFixedWindow(symbols []string, calculations []CalculationSpec, apiKey string, opts ...func(*Options) error) (*FixedWindowData, error) {
+
+	o := defaultOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	url, err := buildURL(common.NewEndpointQuery(fixedWindowURL), symbols, calculations, apiKey, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := getData(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFixedWindowJSON(b, &o)
+}
+
+// GetSlidingWindow uses the provided apiKey to retrieve the calculations over the returns of the symbols,
+// for each window of windowSize returns across the data range
+func GetSlidingWindow(symbols []string, windowSize int, calculations []CalculationSpec, apiKey string, opts ...func(*Options) error) (*SlidingWindowData, error) {
+
+	o := defaultOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	if windowSize < minWindowSize {
+		return nil, fmt.Errorf("window size must be at least %d: %d", minWindowSize, windowSize)
+	}
+	for _, c := range calculations {
+		if !c.Calculation.supportsSliding() {
+			return nil, fmt.Errorf("%s is not available for sliding windows: %w", c, ErrInvalidCalculation)
+		}
+	}
+
+	q := common.NewEndpointQuery(slidingWindowURL).SetInt("WINDOW_SIZE", windowSize)
+
+	url, err := buildURL(q, symbols, calculations, apiKey, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := getData(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSlidingWindowJSON(b, windowSize, &o)
+}
+
+func buildURL(q *common.Query, symbols []string, calculations []CalculationSpec, apiKey string, o *Options) (string, error) {
+
+	if len(symbols) == 0 {
+		return "", ErrNoSymbols
+	}
+	syms := []string{}
+	for _, s := range symbols {
+		if len(s) == 0 {
+			return "", ErrNoSymbols
+		}
+		sym, err := common.ParseSymbol(s)
+		if err != nil {
+			return "", err
+		}
+		syms = append(syms, sym.String())
+	}
+
+	if len(calculations) == 0 {
+		return "", ErrInvalidCalculation
+	}
+	calcs := []string{}
+	for _, c := range calculations {
+		if !c.isValid() {
+			return "", ErrInvalidCalculation
+		}
+		calcs = append(calcs, c.String())
+	}
+
+	q.SetList("SYMBOLS", syms)
+
+	if !o.From.IsZero() {
+		q.SetDate("RANGE", o.From).Add("RANGE", o.To.Format(time.DateOnly))
+	} else if o.Period != "" {
+		q.Set("RANGE", o.Period)
+	} else {
+		q.Set("RANGE", "full")
+	}
+
+	interval := strings.ToUpper(o.Frequency.String())
+	if o.Frequency == common.Intraday {
+		interval = o.Interval.String()
+	}
+
+	return q.Set("INTERVAL", interval).
+		Set("OHLC", o.OHLC.String()).
+		SetList("CALCULATIONS", calcs).
+		URL(apiKey)
+}
+
+func getData(url string) ([]byte, error) {
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return b, nil
+}
+
+// parseResponse handles the parts of the response common to both endpoints
+func parseResponse(b []byte, o *Options) (*Metadata, map[string]json.RawMessage, error) {
+	var d respJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+	if d.Meta == nil {
+		return nil, nil, fmt.Errorf("no metadata available to be parsed: %w", common.ErrMetadataParseError)
+	}
+	if d.Payload == nil || d.Payload.Calculations == nil {
+		return nil, nil, fmt.Errorf("no calculations available to be parsed: %w", common.ErrParseError)
+	}
+
+	meta := &Metadata{
+		Symbols:   strings.Split(d.Meta.Symbols, ","),
+		OHLC:      o.OHLC,
+		Frequency: o.Frequency,
+		Interval:  o.Interval,
+	}
+
+	var err error
+	if meta.Start, err = common.ParseDateOrIntradayDate(d.Meta.MinDate); err != nil {
+		return nil, nil, fmt.Errorf("invalid min_dt %s: %w", d.Meta.MinDate, common.ErrMetadataParseError)
+	}
+	if meta.End, err = common.ParseDateOrIntradayDate(d.Meta.MaxDate); err != nil {
+		return nil, nil, fmt.Errorf("invalid max_dt %s: %w", d.Meta.MaxDate, common.ErrMetadataParseError)
+	}
+
+	return meta, d.Payload.Calculations, nil
+}
+
+func parseFixedWindowJSON(b []byte, o *Options) (*FixedWindowData, error) {
+
+	meta, calcs, err := parseResponse(b, o)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FixedWindowData{
+		Meta:       meta,
+		Values:     map[Calculation]map[string]float64{},
+		Drawdowns:  map[string]*Drawdown{},
+		Histograms: map[string]*HistogramBins{},
+		Matrices:   map[Calculation]*Matrix{},
+	}
+
+	for k, v := range calcs {
+		c, err := parseCalculation(k)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+		}
+
+		switch {
+		case c == MaxDrawdown:
+			err = parseDrawdowns(v, result.Drawdowns)
+		case c == Histogram:
+			err = parseHistograms(v, result.Histograms)
+		case c.isPairwise():
+			result.Matrices[c], err = parseMatrix(v)
+		default:
+			var m map[string]float64
+			err = json.Unmarshal(v, &m)
+			result.Values[c] = m
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v: %w", k, err, common.ErrParseError)
+		}
+	}
+
+	return result, nil
+}
+
+func parseDrawdowns(b json.RawMessage, drawdowns map[string]*Drawdown) error {
+	var m map[string]*respDrawdownJSON
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	for symbol, d := range m {
+		if d == nil {
+			return fmt.Errorf("no drawdown for %s", symbol)
+		}
+		start, err := common.ParseDateOrIntradayDate(d.Range.Start)
+		if err != nil {
+			return err
+		}
+		end, err := common.ParseDateOrIntradayDate(d.Range.End)
+		if err != nil {
+			return err
+		}
+		drawdowns[symbol] = &Drawdown{
+			MaxDrawdown: d.MaxDrawdown,
+			Start:       start,
+			End:         end,
+		}
+	}
+	return nil
+}
+
+func parseHistograms(b json.RawMessage, histograms map[string]*HistogramBins) error {
+	var m map[string]*respHistogramJSON
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	for symbol, h := range m {
+		if h == nil || len(h.Edges) != len(h.Counts)+1 {
+			return fmt.Errorf("invalid histogram for %s", symbol)
+		}
+		histograms[symbol] = &HistogramBins{
+			Counts: h.Counts,
+			Edges:  h.Edges,
+		}
+	}
+	return nil
+}
+
+// parseMatrix expects an "index" listing the symbols, and a lower triangular matrix
+// under the name of the calculation, e.g. "correlation"
+func parseMatrix(b json.RawMessage) (*Matrix, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	result := &Matrix{}
+	if err := json.Unmarshal(m["index"], &result.Symbols); err != nil {
+		return nil, fmt.Errorf("invalid index: %v", err)
+	}
+
+	var lower [][]float64
+	for k, v := range m {
+		if k == "index" {
+			continue
+		}
+		if err := json.Unmarshal(v, &lower); err != nil {
+			return nil, fmt.Errorf("invalid matrix: %v", err)
+		}
+	}
+
+	n := len(result.Symbols)
+	if len(lower) != n {
+		return nil, fmt.Errorf("matrix has %d rows, expected %d", len(lower), n)
+	}
+
+	result.Values = make([][]float64, n)
+	for i := range n {
+		result.Values[i] = make([]float64, n)
+	}
+	for i, row := range lower {
+		if len(row) > n {
+			return nil, fmt.Errorf("matrix row %d has %d columns, expected at most %d", i, len(row), n)
+		}
+		for j, v := range row {
+			result.Values[i][j] = v
+			result.Values[j][i] = v
+		}
+	}
+
+	return result, nil
+}
+
+func parseSlidingWindowJSON(b []byte, windowSize int, o *Options) (*SlidingWindowData, error) {
+
+	meta, calcs, err := parseResponse(b, o)
+	if err != nil {
+		return nil, err
+	}
+	meta.WindowSize = windowSize
+
+	result := &SlidingWindowData{
+		Meta:   meta,
+		Series: map[Calculation]map[string][]*historic.WindowedElement{},
+	}
+
+	for k, v := range calcs {
+		c, err := parseCalculation(k)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+		}
+
+		// Results are nested within a single key, such as "RUNNING_MEAN"
+		var running map[string]map[string]json.RawMessage
+		if err := json.Unmarshal(v, &running); err != nil {
+			return nil, fmt.Errorf("%s: %v: %w", k, err, common.ErrParseError)
+		}
+
+		series := map[string][]*historic.WindowedElement{}
+		for _, r := range running {
+			for name, values := range r {
+				if err := parseRunningValues(name, values, series); err != nil {
+					return nil, fmt.Errorf("%s: %v: %w", k, err, common.ErrParseError)
+				}
+			}
+		}
+		result.Series[c] = series
+	}
+
+	return result, nil
+}
+
+// parseRunningValues handles either a map of date to value, or for pairwise calculations
+// a map of the second symbol to a map of date to value
+func parseRunningValues(name string, b json.RawMessage, series map[string][]*historic.WindowedElement) error {
+
+	var values map[string]float64
+	if err := json.Unmarshal(b, &values); err == nil {
+		s, err := toWindowedElements(values)
+		if err != nil {
+			return err
+		}
+		series[name] = s
+		return nil
+	}
+
+	var pairs map[string]map[string]float64
+	if err := json.Unmarshal(b, &pairs); err != nil {
+		return fmt.Errorf("unexpected values for %s: %v", name, err)
+	}
+	for other, values := range pairs {
+		s, err := toWindowedElements(values)
+		if err != nil {
+			return err
+		}
+		series[name+"-"+other] = s
+	}
+	return nil
+}
+
+func toWindowedElements(values map[string]float64) ([]*historic.WindowedElement, error) {
+	result := make([]*historic.WindowedElement, 0, len(values))
+	for k, v := range values {
+		t, err := common.ParseDateOrIntradayDate(k)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &historic.WindowedElement{
+			WindowStart: t,
+			Value:       v,
+		})
+	}
+
+	// Sort is descending ... most recent date first, consistent with historic.GetWindowedCalculation
+	slices.SortFunc(result, func(a, b *historic.WindowedElement) int {
+		return b.WindowStart.Compare(a.WindowStart)
+	})
+	return result, nil
+}
@@ -0,0 +1,138 @@
+package analytics
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestParseFixedWindowJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/analytics_fixed_window.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	o := defaultOptions
+
+	result, err := parseFixedWindowJSON(data, &o)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if len(result.Meta.Symbols) != 3 || result.Meta.End != time.Date(2023, 8, 31, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected metadata: %v", result.Meta)
+	}
+
+	if !common.EqualFloat64(0.1530428, result.Values[StdDev]["IBM"], 7) {
+		t.Fatalf("unexpected IBM stddev: %v", result.Values[StdDev]["IBM"])
+	}
+
+	d := result.Drawdowns["AAPL"]
+	if d == nil || !common.EqualFloat64(-0.1162, d.MaxDrawdown, 4) || d.Start != time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected AAPL drawdown: %v", d)
+	}
+
+	if h := result.Histograms["AAPL"]; h == nil || len(h.Counts) != 3 || h.Counts[1] != 30 {
+		t.Fatalf("unexpected AAPL histogram: %v", h)
+	}
+
+	m := result.Matrices[Correlation]
+	if m == nil {
+		t.Fatal("expected correlation matrix")
+	}
+
+	if v, ok := m.Get("IBM", "MSFT"); !ok || !common.EqualFloat64(0.2951, v, 4) {
+		t.Fatalf("unexpected IBM/MSFT correlation: %v", v)
+	}
+
+	if v, ok := m.Get("MSFT", "IBM"); !ok || !common.EqualFloat64(0.2951, v, 4) {
+		t.Fatalf("unexpected MSFT/IBM correlation: %v", v)
+	}
+
+	if _, ok := m.Get("IBM", "TSLA"); ok {
+		t.Fatal("unexpected correlation for missing symbol")
+	}
+
+	wr := result.WindowedResult("IBM")
+	if len(wr.TimeSeries) != 2 || wr.TimeSeries[Mean.String()][0].WindowStart != result.Meta.End {
+		t.Fatalf("unexpected windowed result: %v", wr.TimeSeries)
+	}
+}
+
+func TestParseSlidingWindowJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/analytics_sliding_window.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	o := defaultOptions
+
+	result, err := parseSlidingWindowJSON(data, 10, &o)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	s := result.Series[Mean]["IBM"]
+	if len(s) != 4 {
+		t.Fatalf("expected 4 elements, got %d", len(s))
+	}
+
+	// Most recent first, consistent with historic.GetWindowedCalculation
+	if s[0].WindowStart != time.Date(2023, 7, 21, 0, 0, 0, 0, time.UTC) || !common.EqualFloat64(0.0019, s[0].Value, 4) {
+		t.Fatalf("unexpected first element: %v", s[0])
+	}
+
+	if c := result.Series[Correlation]["AAPL-IBM"]; len(c) != 4 || !common.EqualFloat64(0.351, c[0].Value, 3) {
+		t.Fatalf("unexpected correlation: %v", c)
+	}
+
+	wr := result.WindowedResult("AAPL")
+	if wr.Meta.WindowLength != 10 || len(wr.TimeSeries[Mean.String()]) != 4 {
+		t.Fatalf("unexpected windowed result: %v", wr)
+	}
+}
+
+func TestBuildURL(t *testing.T) {
+
+	o := defaultOptions
+	if err := WithDateRange(time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 8, 31, 0, 0, 0, 0, time.UTC))(&o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	calcs := []CalculationSpec{
+		{Calculation: Mean},
+		{Calculation: StdDev, Annualized: true},
+		{Calculation: Correlation, Method: Kendall},
+	}
+
+	url, err := buildURL(common.NewEndpointQuery(fixedWindowURL), []string{"aapl", "MSFT"}, calcs, "KEY", &o)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "https://alphavantageapi.co/timeseries/analytics?CALCULATIONS=MEAN%2CSTDDEV%28annualized%3DTrue%29%2CCORRELATION%28method%3DKENDALL%29&INTERVAL=DAILY&OHLC=close&RANGE=2023-07-01&RANGE=2023-08-31&SYMBOLS=AAPL%2CMSFT&apikey=KEY"
+	if url != expected {
+		t.Fatalf("unexpected url: expected %s, got %s", expected, url)
+	}
+}
+
+func TestGetSlidingWindow(t *testing.T) {
+
+	// Invalid requests are rejected before any remote call is made
+	if _, err := GetSlidingWindow([]string{"IBM"}, 20, []CalculationSpec{{Calculation: MaxDrawdown}}, "KEY"); !errors.Is(err, ErrInvalidCalculation) {
+		t.Fatalf("unexpected error: expected %v, got %v", ErrInvalidCalculation, err)
+	}
+
+	if _, err := GetSlidingWindow([]string{"IBM"}, 5, []CalculationSpec{{Calculation: Mean}}, "KEY"); err == nil {
+		t.Fatal("expected error for small window, got nil")
+	}
+
+	if _, err := GetFixedWindow(nil, []CalculationSpec{{Calculation: Mean}}, "KEY"); err != ErrNoSymbols {
+		t.Fatalf("unexpected error: expected %v, got %v", ErrNoSymbols, err)
+	}
+}
@@ -0,0 +1,108 @@
+package analytics
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/historic"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+// Options can change the returned data from GetFixedWindow and GetSlidingWindow
+type Options struct {
+	// From and To, if set, restrict the calculations to the dates between them.  Default: full history
+	From time.Time
+	To   time.Time
+	// Period, if set, restricts the calculations to the most recent period, e.g. "6month".  Default: full history
+	Period string
+	// OHLC is the price used to calculate returns, which must be one of Open, High, Low or Close.  Default: Close
+	OHLC historic.InformationType
+	// Frequency is the spacing of the returns.  Default: daily
+	Frequency common.Frequency
+	// Interval is the spacing of the returns when Frequency is Intraday
+	Interval intraday.Interval
+}
+
+// WithDateRange restricts the calculations to the returns between from and to inclusive
+func WithDateRange(from, to time.Time) func(*Options) error {
+	return func(o *Options) error {
+		if from.IsZero() || to.IsZero() || to.Before(from) {
+			return fmt.Errorf("invalid date range: %v to %v", from, to)
+		}
+		o.From = from
+		o.To = to
+		o.Period = ""
+		return nil
+	}
+}
+
+// WithPeriod restricts the calculations to the most recent n days, weeks, months or years
+func WithPeriod(n int, unit common.Frequency) func(*Options) error {
+	return func(o *Options) error {
+		if n < 1 {
+			return fmt.Errorf("invalid period length: %d", n)
+		}
+		var s string
+		switch unit {
+		case common.Daily:
+			s = "day"
+		case common.Weekly:
+			s = "week"
+		case common.Monthly:
+			s = "month"
+		case common.Annual:
+			s = "year"
+		default:
+			return common.ErrInvalidInterval
+		}
+		o.Period = fmt.Sprintf("%d%s", n, s)
+		o.From = time.Time{}
+		o.To = time.Time{}
+		return nil
+	}
+}
+
+// WithOHLC sets the price used to calculate returns
+func WithOHLC(it historic.InformationType) func(*Options) error {
+	return func(o *Options) error {
+		switch it {
+		case historic.Open, historic.High, historic.Low, historic.Close:
+			o.OHLC = it
+			return nil
+		default:
+			return errors.New("OHLC must be one of open, high, low or close")
+		}
+	}
+}
+
+// WithFrequency sets the Daily, Weekly or Monthly spacing of returns
+func WithFrequency(frequency common.Frequency) func(*Options) error {
+	return func(o *Options) error {
+		switch frequency {
+		case common.Daily, common.Weekly, common.Monthly:
+			o.Frequency = frequency
+			return nil
+		default:
+			return common.ErrInvalidInterval
+		}
+	}
+}
+
+// WithInterval sets an intraday spacing of returns
+func WithInterval(interval intraday.Interval) func(*Options) error {
+	return func(o *Options) error {
+		if !interval.IsValid() {
+			return common.ErrInvalidInterval
+		}
+		o.Frequency = common.Intraday
+		o.Interval = interval
+		return nil
+	}
+}
+
+var defaultOptions = Options{
+	OHLC:      historic.Close,
+	Frequency: common.Daily,
+}
@@ -0,0 +1,106 @@
+package commodities
+
+import (
+	"slices"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+type Commodity int
+
+const (
+	UnknownCommodity Commodity = iota
+	WTI
+	Brent
+	NaturalGas
+	Copper
+	Aluminum
+	Wheat
+	Corn
+	Cotton
+	Sugar
+	Coffee
+	AllCommodities
+	InvalidCommodity
+)
+
+func (c Commodity) String() string {
+	switch c {
+	case WTI:
+		return "WTI crude oil"
+	case Brent:
+		return "Brent crude oil"
+	case NaturalGas:
+		return "natural gas"
+	case Copper:
+		return "copper"
+	case Aluminum:
+		return "aluminum"
+	case Wheat:
+		return "wheat"
+	case Corn:
+		return "corn"
+	case Cotton:
+		return "cotton"
+	case Sugar:
+		return "sugar"
+	case Coffee:
+		return "coffee"
+	case AllCommodities:
+		return "global commodities index"
+	default:
+		panic("invalid value of Commodity")
+	}
+}
+
+// Function returns the Alpha Vantage function that retrieves the commodity.  Panics if the Commodity is invalid.
+func (c Commodity) Function() string {
+	return c.toAVString()
+}
+
+func (c Commodity) toAVString() string {
+	switch c {
+	case WTI:
+		return "WTI"
+	case Brent:
+		return "BRENT"
+	case NaturalGas:
+		return "NATURAL_GAS"
+	case Copper:
+		return "COPPER"
+	case Aluminum:
+		return "ALUMINUM"
+	case Wheat:
+		return "WHEAT"
+	case Corn:
+		return "CORN"
+	case Cotton:
+		return "COTTON"
+	case Sugar:
+		return "SUGAR"
+	case Coffee:
+		return "COFFEE"
+	case AllCommodities:
+		return "ALL_COMMODITIES"
+	default:
+		panic("invalid value of Commodity")
+	}
+}
+
+// IsValid returns true if the Commodity can be used in a request
+func (c Commodity) IsValid() bool {
+	if c <= UnknownCommodity || c >= InvalidCommodity {
+		return false
+	}
+	return true
+}
+
+// supportsInterval returns true if Alpha Vantage provides the commodity at the specified interval
+func (c Commodity) supportsInterval(f common.Frequency) bool {
+	switch c {
+	case WTI, Brent, NaturalGas:
+		return slices.Contains([]common.Frequency{common.Daily, common.Weekly, common.Monthly}, f)
+	default:
+		return slices.Contains([]common.Frequency{common.Monthly, common.Quarterly, common.Annual}, f)
+	}
+}
@@ -0,0 +1,105 @@
+package commodities
+
+import (
+	"testing"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestCommodity(t *testing.T) {
+
+	type test struct {
+		v           Commodity
+		shouldPanic bool
+	}
+
+	tests := []test{
+		{
+			v: WTI,
+		},
+		{
+			v: Brent,
+		},
+		{
+			v: NaturalGas,
+		},
+		{
+			v: Copper,
+		},
+		{
+			v: Aluminum,
+		},
+		{
+			v: Wheat,
+		},
+		{
+			v: Corn,
+		},
+		{
+			v: Cotton,
+		},
+		{
+			v: Sugar,
+		},
+		{
+			v: Coffee,
+		},
+		{
+			v: AllCommodities,
+		},
+		{
+			v:           0,
+			shouldPanic: true,
+		},
+		{
+			v:           -99,
+			shouldPanic: true,
+		},
+		{
+			v:           99,
+			shouldPanic: true,
+		},
+	}
+
+	runTest := func(v Commodity, shouldPanic bool) {
+		var panicked = new(bool)
+
+		test := func() {
+			defer func() {
+				if (shouldPanic && !*panicked) || (!shouldPanic && *panicked) {
+					t.Fatalf("unexpected error for %d", v)
+				}
+			}()
+			defer func() {
+				if r := recover(); r != nil {
+					*panicked = true
+				}
+			}()
+
+			_ = v.String()
+			_ = v.toAVString()
+		}
+
+		test()
+	}
+
+	for _, tst := range tests {
+		runTest(tst.v, tst.shouldPanic)
+	}
+}
+
+func TestSupportsInterval(t *testing.T) {
+
+	if !WTI.supportsInterval(common.Daily) {
+		t.Fatal("expected WTI to support daily")
+	}
+	if WTI.supportsInterval(common.Annual) {
+		t.Fatal("expected WTI not to support annual")
+	}
+	if Copper.supportsInterval(common.Daily) {
+		t.Fatal("expected copper not to support daily")
+	}
+	if !Copper.supportsInterval(common.Quarterly) {
+		t.Fatal("expected copper to support quarterly")
+	}
+}
@@ -0,0 +1,87 @@
+package commodities
+
+import (
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/historic"
+)
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Commodity is the requested commodity
+	Commodity Commodity
+	// Name is the description of the series provided by Alpha Vantage
+	Name string
+	// Interval is the spacing of the elements in the time series
+	Interval common.Frequency
+	// Unit describes the unit of the values, e.g. "dollars per barrel"
+	Unit string
+	// DataRange describes the range of data that was returned
+	DataRange *DataRange
+}
+
+// Data Range describes the range of data history
+type DataRange struct {
+	// Start is the start time of the requested data range (earliest)
+	Start time.Time
+	// End is the end time of the requested data range (latest)
+	End time.Time
+}
+
+// Element is an entry in the TimeSeries
+type Element struct {
+	// Date is the date of the value
+	Date time.Time
+	// Value is the price of the commodity, undefined where Alpha Vantage has no value for the date
+	Value common.OptionalFloat64
+}
+
+// Data is the returned object from a call to GetData
+type Data struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// TimeSeries is an ordered set of data
+	TimeSeries []*Element
+}
+
+// ToHistoric converts the Data into historic.Data, with each value stored as historic.Close, so that
+// it can be used with historic.GetWindowedCalculation.
+// Elements with undefined values are omitted, so windows span the gaps in the series.
+// Returns nil if the Data has no Metadata.
+func (d *Data) ToHistoric() *historic.Data {
+
+	if d.Meta == nil {
+		return nil
+	}
+
+	result := &historic.Data{
+		Meta: &historic.Metadata{
+			Information: []historic.InformationType{historic.Close},
+			Symbol:      d.Meta.Commodity.toAVString(),
+		},
+		TimeSeries: []*historic.Element{},
+	}
+
+	for _, e := range d.TimeSeries {
+		if e.Value.IsUndefined() {
+			continue
+		}
+		result.TimeSeries = append(result.TimeSeries, &historic.Element{
+			Date: e.Date,
+			Data: map[historic.InformationType]float64{
+				historic.Close: e.Value.Value,
+			},
+		})
+	}
+
+	if n := len(result.TimeSeries); n > 0 {
+		result.Meta.LastRefresh = result.TimeSeries[0].Date
+		result.Meta.DataRange = &historic.DataRange{
+			Start: result.TimeSeries[n-1].Date,
+			End:   result.TimeSeries[0].Date,
+		}
+	}
+
+	return result
+}
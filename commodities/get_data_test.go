@@ -0,0 +1,88 @@
+package commodities
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/historic"
+)
+
+func TestParseJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/wti_daily.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseJSON(data, WTI)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Interval != common.Daily {
+		t.Fatalf("expected daily interval, got %v", result.Meta.Interval)
+	}
+
+	if result.Meta.Unit != "dollars per barrel" {
+		t.Fatalf("unexpected unit: %s", result.Meta.Unit)
+	}
+
+	if len(result.TimeSeries) != 10 {
+		t.Fatalf("expected 10 elements, got %d", len(result.TimeSeries))
+	}
+
+	if result.TimeSeries[0].Date != time.Date(2025, 8, 25, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("expected first element at 2025-08-25, got %v", result.TimeSeries[0].Date)
+	}
+
+	if !common.EqualFloat64(64.80, result.TimeSeries[0].Value.Value, 2) {
+		t.Fatalf("unexpected first value: %v", result.TimeSeries[0].Value)
+	}
+
+	if !result.TimeSeries[4].Value.IsUndefined() {
+		t.Fatalf("expected missing value for 2025-08-19, got %v", result.TimeSeries[4].Value)
+	}
+}
+
+func TestToHistoric(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/wti_daily.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseJSON(data, WTI)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	h := result.ToHistoric()
+	if len(h.TimeSeries) != 9 {
+		t.Fatalf("expected 9 elements after removing gaps, got %d", len(h.TimeSeries))
+	}
+
+	var tag = "Change"
+	results, err := historic.GetWindowedCalculation(context.Background(), h, 1, historic.Close, map[string]historic.WindowFunc{
+		tag: historic.WindowChange,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(results.TimeSeries[tag]) != 8 {
+		t.Fatalf("expected 8 results, got %d", len(results.TimeSeries[tag]))
+	}
+
+	// The change for 2025-08-20 spans the missing value on 2025-08-19
+	ele := results.TimeSeries[tag][3]
+	if ele.WindowStart != time.Date(2025, 8, 20, 0, 0, 0, 0, time.UTC) || !common.EqualFloat64(-0.09, ele.Value, 2) {
+		t.Fatalf("unexpected result: %v %v", ele.WindowStart, ele.Value)
+	}
+
+	if h := (&Data{}).ToHistoric(); h != nil {
+		t.Fatalf("expected nil without metadata, got %v", h)
+	}
+}
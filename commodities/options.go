@@ -0,0 +1,25 @@
+package commodities
+
+import "github.com/gford1000-go/alphav/common"
+
+// Options can change the returned Data from GetData
+type Options struct {
+	// Interval specifies the interval between time series elements.  Default: monthly
+	Interval common.Frequency
+}
+
+// WithInterval sets the interval between elements.
+// WTI, Brent and NaturalGas support Daily, Weekly and Monthly; the other commodities support Monthly, Quarterly and Annual.
+func WithInterval(interval common.Frequency) func(*Options) error {
+	return func(o *Options) error {
+		if !interval.IsValid() || interval == common.Intraday {
+			return common.ErrInvalidInterval
+		}
+		o.Interval = interval
+		return nil
+	}
+}
+
+var defaultOptions = Options{
+	Interval: common.Monthly,
+}
@@ -11,3 +11,12 @@ func ParseIntradayDate(tmStr string) (time.Time, error) {
 func ParseDate(dtStr string) (time.Time, error) {
 	return time.Parse("2006-01-02", dtStr)
 }
+
+// ParseDateOrIntradayDate parses a string in either the "2006-01-02 15:04:05" or "2006-01-02" format,
+// for responses where the format depends upon the requested interval.
+func ParseDateOrIntradayDate(s string) (time.Time, error) {
+	if t, err := ParseIntradayDate(s); err == nil {
+		return t, nil
+	}
+	return ParseDate(s)
+}
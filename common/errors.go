@@ -22,3 +22,9 @@ var ErrInvalidInformationType = errors.New("invalid information type specified")
 
 // ErrContextEnded returned when the context is ended before completion
 var ErrContextEnded = errors.New("context ended before completion")
+
+// ErrInvalidSymbol returned when a symbol is empty or contains characters that cannot be used in a request
+var ErrInvalidSymbol = errors.New("invalid symbol specified")
+
+// ErrInvalidParameter returned when a request parameter is missing or has an invalid value
+var ErrInvalidParameter = errors.New("invalid parameter specified")
@@ -0,0 +1,69 @@
+package common
+
+import "fmt"
+
+// Frequency describes the spacing of the elements in a time series
+type Frequency int
+
+const (
+	UnknownFrequency Frequency = iota
+	Intraday
+	Daily
+	Weekly
+	Monthly
+	Quarterly
+	SemiAnnual
+	Annual
+	InvalidFrequency
+)
+
+func (f Frequency) String() string {
+	switch f {
+	case Intraday:
+		return "intraday"
+	case Daily:
+		return "daily"
+	case Weekly:
+		return "weekly"
+	case Monthly:
+		return "monthly"
+	case Quarterly:
+		return "quarterly"
+	case SemiAnnual:
+		return "semiannual"
+	case Annual:
+		return "annual"
+	default:
+		panic("invalid value of Frequency")
+	}
+}
+
+// IsValid returns true if the Frequency is one of the defined values
+func (f Frequency) IsValid() bool {
+	if f <= UnknownFrequency || f >= InvalidFrequency {
+		return false
+	}
+	return true
+}
+
+// ParseFrequency returns the Frequency described by the Alpha Vantage string, such as "monthly"
+func ParseFrequency(s string) (Frequency, error) {
+	switch s {
+	case "intraday":
+		return Intraday, nil
+	case "daily":
+		return Daily, nil
+	case "weekly":
+		return Weekly, nil
+	case "monthly":
+		return Monthly, nil
+	case "quarterly":
+		return Quarterly, nil
+	case "semiannual":
+		return SemiAnnual, nil
+	case "annual":
+		return Annual, nil
+	default:
+		return UnknownFrequency, fmt.Errorf("unparseable frequency: %s", s)
+	}
+}
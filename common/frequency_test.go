@@ -0,0 +1,20 @@
+package common
+
+import "testing"
+
+func TestFrequency(t *testing.T) {
+
+	for f := UnknownFrequency + 1; f < InvalidFrequency; f++ {
+		f1, err := ParseFrequency(f.String())
+		if err != nil {
+			t.Fatalf("unexpected parse failure for %s", f)
+		}
+		if f != f1 {
+			t.Fatalf("unexpected parse output: expected: %v, got: %v", f, f1)
+		}
+	}
+
+	if _, err := ParseFrequency("fortnightly"); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -0,0 +1,137 @@
+package common
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QueryURL is the endpoint of the Alpha Vantage query functions
+const QueryURL = "https://www.alphavantage.co/query"
+
+// Query builds the URL of a request, escaping each parameter value so that it cannot alter the request.
+// The setters validate their values, with the first error being returned by URL.
+type Query struct {
+	endpoint string
+	values   url.Values
+	err      error
+}
+
+// NewQuery creates a Query for the Alpha Vantage function
+func NewQuery(function string) *Query {
+	q := NewEndpointQuery(QueryURL)
+	return q.Set("function", function)
+}
+
+// NewEndpointQuery creates a Query for an endpoint that does not use the function parameter
+func NewEndpointQuery(endpoint string) *Query {
+	return &Query{
+		endpoint: endpoint,
+		values:   url.Values{},
+	}
+}
+
+func (q *Query) setErr(err error) *Query {
+	if q.err == nil {
+		q.err = err
+	}
+	return q
+}
+
+// Set sets the parameter to the value, which must not be empty
+func (q *Query) Set(key, value string) *Query {
+	if len(value) == 0 {
+		return q.setErr(fmt.Errorf("%s: %w", key, ErrInvalidParameter))
+	}
+	q.values.Set(key, value)
+	return q
+}
+
+// Add appends the value to any existing values of the parameter, which must not be empty
+func (q *Query) Add(key, value string) *Query {
+	if len(value) == 0 {
+		return q.setErr(fmt.Errorf("%s: %w", key, ErrInvalidParameter))
+	}
+	q.values.Add(key, value)
+	return q
+}
+
+// SetInt sets the parameter to the integer value
+func (q *Query) SetInt(key string, value int) *Query {
+	return q.Set(key, strconv.Itoa(value))
+}
+
+// SetBool sets the parameter to true or false
+func (q *Query) SetBool(key string, value bool) *Query {
+	return q.Set(key, strconv.FormatBool(value))
+}
+
+// SetDate sets the parameter to the date, formatted as YYYY-MM-DD
+func (q *Query) SetDate(key string, value time.Time) *Query {
+	if value.IsZero() {
+		return q.setErr(fmt.Errorf("%s: %w", key, ErrInvalidParameter))
+	}
+	return q.Set(key, value.Format(time.DateOnly))
+}
+
+// SetList sets the parameter to the comma separated values, none of which may be empty
+func (q *Query) SetList(key string, values []string) *Query {
+	if len(values) == 0 || slices.Contains(values, "") {
+		return q.setErr(fmt.Errorf("%s: %w", key, ErrInvalidParameter))
+	}
+	return q.Set(key, strings.Join(values, ","))
+}
+
+// Symbol sets the symbol parameter, after it has been validated and normalised by ParseSymbol
+func (q *Query) Symbol(symbol string) *Query {
+	return q.SymbolParam("symbol", symbol)
+}
+
+// SymbolParam sets the parameter to the symbol, after it has been validated and normalised by ParseSymbol
+func (q *Query) SymbolParam(key, symbol string) *Query {
+	s, err := ParseSymbol(symbol)
+	if err != nil {
+		return q.setErr(fmt.Errorf("%s: %w", key, err))
+	}
+	return q.Set(key, s.String())
+}
+
+// Currency sets the parameter to the upper cased physical or digital currency code, which may contain only letters and digits
+func (q *Query) Currency(key, code string) *Query {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) == 0 || len(code) > 10 {
+		return q.setErr(fmt.Errorf("%s: '%s': %w", key, code, ErrInvalidParameter))
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return q.setErr(fmt.Errorf("%s: '%s': %w", key, code, ErrInvalidParameter))
+		}
+	}
+	return q.Set(key, code)
+}
+
+// Err returns the first validation error, if any
+func (q *Query) Err() error {
+	return q.err
+}
+
+// URL returns the request for the apiKey, or the first validation error
+func (q *Query) URL(apiKey string) (string, error) {
+	if q.err != nil {
+		return "", q.err
+	}
+	if len(apiKey) == 0 {
+		return "", fmt.Errorf("apikey: %w", ErrInvalidParameter)
+	}
+
+	values := url.Values{}
+	for k, v := range q.values {
+		values[k] = v
+	}
+	values.Set("apikey", apiKey)
+
+	return q.endpoint + "?" + values.Encode(), nil
+}
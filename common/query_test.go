@@ -0,0 +1,43 @@
+package common
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+
+	url, err := NewQuery("TEST").
+		Set("name", "a&b=c d+e").
+		SetInt("limit", 10).
+		SetList("list", []string{"x", "y"}).
+		URL("K&Y")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "https://www.alphavantage.co/query?apikey=K%26Y&function=TEST&limit=10&list=x%2Cy&name=a%26b%3Dc+d%2Be"
+	if url != expected {
+		t.Fatalf("unexpected url: expected %s, got %s", expected, url)
+	}
+
+	if _, err := NewQuery("TEST").Symbol("IBM").URL(""); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter for missing api key, got %v", err)
+	}
+
+	// The first validation error is returned
+	_, err = NewQuery("TEST").Symbol("I#B").Currency("market", "U$D").URL("KEY")
+	if !errors.Is(err, ErrInvalidSymbol) {
+		t.Fatalf("expected ErrInvalidSymbol, got %v", err)
+	}
+
+	// Share class separators are allowed in symbols, and are escaped
+	url, err = NewQuery("TEST").Symbol("bc/pa").URL("KEY")
+	if err != nil || url != "https://www.alphavantage.co/query?apikey=KEY&function=TEST&symbol=BC%2FPA" {
+		t.Fatalf("unexpected url: %s (%v)", url, err)
+	}
+
+	if _, err := NewQuery("TEST").SetList("list", []string{"x", ""}).URL("KEY"); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("expected ErrInvalidParameter for empty list value, got %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Ensures the time zones of the exchanges are available on all platforms
+	_ "time/tzdata"
+)
+
+// Exchange describes a market on which symbols are traded
+type Exchange struct {
+	// Suffix is appended to a symbol to identify the exchange, e.g. "LON".  US exchanges have no suffix.
+	Suffix string
+	// Name is the name of the exchange
+	Name string
+	// Currency is the currency in which prices are quoted.  Note that London prices are quoted in pence (GBX).
+	Currency string
+	// TimeZone is the IANA name of the time zone of the exchange
+	TimeZone string
+	// Close is the end of the regular trading session, as an offset from midnight in the TimeZone
+	Close time.Duration
+}
+
+// Location returns the time zone of the exchange
+func (e *Exchange) Location() (*time.Location, error) {
+	return time.LoadLocation(e.TimeZone)
+}
+
+// SessionDate returns the date, at midnight UTC, of the trading session to which the time belongs.
+// Times at or after the Close in the exchange time zone belong to the session of the following date,
+// which may not be a trading date.  UTC is used if the time zone is not a valid IANA name.
+func (e *Exchange) SessionDate(t time.Time) time.Time {
+	loc, err := e.Location()
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := t.In(loc)
+	date := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+
+	clock := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute + time.Duration(local.Second())*time.Second
+	if clock >= e.Close {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// USExchange is the Exchange of symbols that have no exchange suffix
+var USExchange = &Exchange{
+	Name:     "United States",
+	Currency: "USD",
+	TimeZone: "America/New_York",
+	Close:    16 * time.Hour,
+}
+
+// exchanges are the non-US exchanges supported by Alpha Vantage, by suffix
+var exchanges = map[string]*Exchange{
+	"LON": {Suffix: "LON", Name: "London Stock Exchange", Currency: "GBX", TimeZone: "Europe/London", Close: 16*time.Hour + 30*time.Minute},
+	"TRT": {Suffix: "TRT", Name: "Toronto Stock Exchange", Currency: "CAD", TimeZone: "America/Toronto", Close: 16 * time.Hour},
+	"TRV": {Suffix: "TRV", Name: "Toronto Venture Exchange", Currency: "CAD", TimeZone: "America/Toronto", Close: 16 * time.Hour},
+	"DEX": {Suffix: "DEX", Name: "XETRA", Currency: "EUR", TimeZone: "Europe/Berlin", Close: 17*time.Hour + 30*time.Minute},
+	"FRK": {Suffix: "FRK", Name: "Frankfurt Stock Exchange", Currency: "EUR", TimeZone: "Europe/Berlin", Close: 17*time.Hour + 30*time.Minute},
+	"PAR": {Suffix: "PAR", Name: "Euronext Paris", Currency: "EUR", TimeZone: "Europe/Paris", Close: 17*time.Hour + 30*time.Minute},
+	"AMS": {Suffix: "AMS", Name: "Euronext Amsterdam", Currency: "EUR", TimeZone: "Europe/Amsterdam", Close: 17*time.Hour + 30*time.Minute},
+	"BSE": {Suffix: "BSE", Name: "Bombay Stock Exchange", Currency: "INR", TimeZone: "Asia/Kolkata", Close: 15*time.Hour + 30*time.Minute},
+	"SHH": {Suffix: "SHH", Name: "Shanghai Stock Exchange", Currency: "CNY", TimeZone: "Asia/Shanghai", Close: 15 * time.Hour},
+	"SHZ": {Suffix: "SHZ", Name: "Shenzhen Stock Exchange", Currency: "CNY", TimeZone: "Asia/Shanghai", Close: 15 * time.Hour},
+}
+
+// LookupExchange returns the Exchange identified by the suffix, if it is known
+func LookupExchange(suffix string) (*Exchange, bool) {
+	e, ok := exchanges[strings.ToUpper(suffix)]
+	return e, ok
+}
+
+// maxSymbolLength is longer than any symbol used by Alpha Vantage, including its exchange suffix
+const maxSymbolLength = 20
+
+// Symbol is a normalised ticker symbol, optionally including an exchange suffix, e.g. "IBM", "BRK.B" or "TSCO.LON"
+type Symbol string
+
+// ParseSymbol validates and normalises the symbol, so that it is safe to use in a request.
+// Symbols are upper cased, and may contain only letters, digits, '-' and single '.' or '/' separators,
+// the latter being used by Alpha Vantage for share classes such as preferred shares, e.g. "BC/PA".
+func ParseSymbol(s string) (Symbol, error) {
+
+	s = strings.ToUpper(strings.TrimSpace(s))
+
+	if len(s) == 0 || len(s) > maxSymbolLength {
+		return "", fmt.Errorf("'%s': %w", s, ErrInvalidSymbol)
+	}
+
+	for i, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-':
+		case r == '.', r == '/':
+			if i == 0 || i == len(s)-1 || s[i-1] == '.' || s[i-1] == '/' {
+				return "", fmt.Errorf("'%s': %w", s, ErrInvalidSymbol)
+			}
+		default:
+			return "", fmt.Errorf("'%s': %w", s, ErrInvalidSymbol)
+		}
+	}
+
+	return Symbol(s), nil
+}
+
+// split separates the symbol into its base and exchange suffix.  Dots that do not precede
+// a known exchange suffix, such as in BRK.B, are part of the base.
+func (s Symbol) split() (string, *Exchange) {
+	if i := strings.LastIndexByte(string(s), '.'); i >= 0 {
+		if e, ok := exchanges[string(s[i+1:])]; ok {
+			return string(s[:i]), e
+		}
+	}
+	return string(s), nil
+}
+
+// Base returns the symbol without any exchange suffix
+func (s Symbol) Base() string {
+	base, _ := s.split()
+	return base
+}
+
+// Suffix returns the exchange suffix of the symbol, or "" for a US symbol
+func (s Symbol) Suffix() string {
+	if _, e := s.split(); e != nil {
+		return e.Suffix
+	}
+	return ""
+}
+
+// Exchange returns the Exchange on which the symbol is traded
+func (s Symbol) Exchange() *Exchange {
+	if _, e := s.split(); e != nil {
+		return e
+	}
+	return USExchange
+}
+
+// IsUS returns true if the symbol has no exchange suffix
+func (s Symbol) IsUS() bool {
+	_, e := s.split()
+	return e == nil
+}
+
+// String returns the symbol as used in requests
+func (s Symbol) String() string {
+	return string(s)
+}
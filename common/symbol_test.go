@@ -0,0 +1,97 @@
+package common
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseSymbol(t *testing.T) {
+
+	type test struct {
+		value    string
+		symbol   Symbol
+		base     string
+		suffix   string
+		currency string
+		wantErr  bool
+	}
+
+	tests := []test{
+		{value: "ibm", symbol: "IBM", base: "IBM", currency: "USD"},
+		{value: " BRK.B ", symbol: "BRK.B", base: "BRK.B", currency: "USD"},
+		{value: "tsco.lon", symbol: "TSCO.LON", base: "TSCO", suffix: "LON", currency: "GBX"},
+		{value: "SHOP.TRT", symbol: "SHOP.TRT", base: "SHOP", suffix: "TRT", currency: "CAD"},
+		{value: "MBG.DEX", symbol: "MBG.DEX", base: "MBG", suffix: "DEX", currency: "EUR"},
+		{value: "RELIANCE.BSE", symbol: "RELIANCE.BSE", base: "RELIANCE", suffix: "BSE", currency: "INR"},
+		{value: "600104.SHH", symbol: "600104.SHH", base: "600104", suffix: "SHH", currency: "CNY"},
+		{value: "BF-B", symbol: "BF-B", base: "BF-B", currency: "USD"},
+		{value: "bc/pa", symbol: "BC/PA", base: "BC/PA", currency: "USD"},
+		{value: "", wantErr: true},
+		{value: ".LON", wantErr: true},
+		{value: "IBM.", wantErr: true},
+		{value: "BRK..B", wantErr: true},
+		{value: "/PA", wantErr: true},
+		{value: "BC//PA", wantErr: true},
+		{value: "BC./PA", wantErr: true},
+		{value: "IBM&apikey=x", wantErr: true},
+		{value: "IBM MSFT", wantErr: true},
+		{value: "A+B", wantErr: true},
+		{value: "ABCDEFGHIJKLMNOPQRSTU", wantErr: true},
+	}
+
+	for _, test := range tests {
+		s, err := ParseSymbol(test.value)
+		if test.wantErr {
+			if !errors.Is(err, ErrInvalidSymbol) {
+				t.Fatalf("%q: expected ErrInvalidSymbol, got %v", test.value, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", test.value, err)
+		}
+		if s != test.symbol || s.Base() != test.base || s.Suffix() != test.suffix || s.Exchange().Currency != test.currency {
+			t.Fatalf("%q: unexpected result: %s %s %s %s", test.value, s, s.Base(), s.Suffix(), s.Exchange().Currency)
+		}
+		if s.IsUS() != (test.suffix == "") {
+			t.Fatalf("%q: unexpected IsUS", test.value)
+		}
+	}
+}
+
+func TestSessionDate(t *testing.T) {
+
+	type test struct {
+		exchange *Exchange
+		t        time.Time
+		expected time.Time
+	}
+
+	tests := []test{
+		{USExchange, time.Date(2025, 8, 21, 19, 59, 59, 0, time.UTC), time.Date(2025, 8, 21, 0, 0, 0, 0, time.UTC)},
+		{USExchange, time.Date(2025, 8, 21, 20, 0, 0, 0, time.UTC), time.Date(2025, 8, 22, 0, 0, 0, 0, time.UTC)},
+		{USExchange, time.Date(2025, 8, 22, 2, 0, 0, 0, time.UTC), time.Date(2025, 8, 22, 0, 0, 0, 0, time.UTC)}, // 22:00 on the previous date in New York
+		{exchanges["LON"], time.Date(2025, 1, 10, 16, 29, 0, 0, time.UTC), time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{exchanges["LON"], time.Date(2025, 1, 10, 16, 30, 0, 0, time.UTC), time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)},
+		{exchanges["SHH"], time.Date(2025, 1, 10, 6, 59, 0, 0, time.UTC), time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{exchanges["SHH"], time.Date(2025, 1, 10, 7, 0, 0, 0, time.UTC), time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		if got := test.exchange.SessionDate(test.t); !got.Equal(test.expected) {
+			t.Fatalf("%s %v: expected %v, got %v", test.exchange.Name, test.t, test.expected, got)
+		}
+	}
+}
+
+func TestExchangeLocation(t *testing.T) {
+	// The embedded time zone database means these load without a system zoneinfo
+	for _, e := range append([]*Exchange{USExchange}, slices.Collect(maps.Values(exchanges))...) {
+		if _, err := e.Location(); err != nil {
+			t.Fatalf("%s: %v", e.Suffix, err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OptionalFloat64 allows values that are missing from the returned data to be identifiable
+type OptionalFloat64 struct {
+	// Value is the parsed value, which is zero if undefined
+	Value float64
+	// Defined is true if a value was present in the returned data
+	Defined bool
+}
+
+// IsUndefined returns true if no value was present in the returned data
+func (o OptionalFloat64) IsUndefined() bool {
+	return !o.Defined
+}
+
+// OptionalDate allows dates that are missing from the returned data to be identifiable
+type OptionalDate struct {
+	// Value is the parsed date, which is the zero time if undefined
+	Value time.Time
+	// Defined is true if a date was present in the returned data
+	Defined bool
+}
+
+// IsUndefined returns true if no date was present in the returned data
+func (o OptionalDate) IsUndefined() bool {
+	return !o.Defined
+}
+
+// IsMissing returns true if the string is one of the placeholders Alpha Vantage uses for a missing value
+func IsMissing(s string) bool {
+	switch s {
+	case "", "None", "none", "-", ".", "null", "N/A", "n/a":
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseOptionalFloat64 parses the string as a float64, returning an undefined value if the string
+// is a missing value placeholder
+func ParseOptionalFloat64(s string) (OptionalFloat64, error) {
+	if IsMissing(s) {
+		return OptionalFloat64{}, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return OptionalFloat64{}, err
+	}
+	return OptionalFloat64{Value: v, Defined: true}, nil
+}
+
+// ParseOptionalDate parses a string in the format "2006-01-02", returning an undefined value if the string
+// is a missing value placeholder
+func ParseOptionalDate(s string) (OptionalDate, error) {
+	if IsMissing(s) {
+		return OptionalDate{}, nil
+	}
+	t, err := ParseDate(s)
+	if err != nil {
+		return OptionalDate{}, err
+	}
+	return OptionalDate{Value: t, Defined: true}, nil
+}
+
+// MetaValue returns the value of the metadata whose key ends with the specified name, ignoring the
+// numeric prefix that Alpha Vantage adds to keys, e.g. "2. Digital Currency Name".  Returns "" if not found.
+func MetaValue(m map[string]string, name string) string {
+	for k, v := range m {
+		if strings.HasSuffix(k, name) {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package common
+
+import "testing"
+
+func TestParseOptionalFloat64(t *testing.T) {
+
+	type test struct {
+		value   string
+		result  OptionalFloat64
+		wantErr bool
+	}
+
+	tests := []test{
+		{
+			value:  "1.23",
+			result: OptionalFloat64{Value: 1.23, Defined: true},
+		},
+		{
+			value:  "-4",
+			result: OptionalFloat64{Value: -4, Defined: true},
+		},
+		{
+			value:  "None",
+			result: OptionalFloat64{},
+		},
+		{
+			value:  "-",
+			result: OptionalFloat64{},
+		},
+		{
+			value:  ".",
+			result: OptionalFloat64{},
+		},
+		{
+			value:  "",
+			result: OptionalFloat64{},
+		},
+		{
+			value:   "abc",
+			wantErr: true,
+		},
+	}
+
+	for i, tt := range tests {
+		r, err := ParseOptionalFloat64(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%d: unexpected error state: %v", i, err)
+		}
+		if r != tt.result {
+			t.Fatalf("%d: mismatch for %v", i, r)
+		}
+	}
+}
+
+func TestParseOptionalDate(t *testing.T) {
+
+	d, err := ParseOptionalDate("2025-08-19")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.IsUndefined() {
+		t.Fatal("expected defined date")
+	}
+
+	d, err = ParseOptionalDate("None")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !d.IsUndefined() {
+		t.Fatal("expected undefined date")
+	}
+}
+
+func TestMetaValue(t *testing.T) {
+
+	m := map[string]string{
+		"1. Information":           "Daily Prices",
+		"2. Digital Currency Code": "BTC",
+		"3. Digital Currency Name": "Bitcoin",
+	}
+
+	if v := MetaValue(m, "Digital Currency Code"); v != "BTC" {
+		t.Fatalf("unexpected value: %s", v)
+	}
+	if v := MetaValue(m, "Market Code"); v != "" {
+		t.Fatalf("expected empty value, got %s", v)
+	}
+}
@@ -0,0 +1,64 @@
+package crypto
+
+import (
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Information specifies the selected InformationTypes
+	Information []InformationType
+	// Symbol is the digital currency code, e.g. BTC
+	Symbol string
+	// SymbolName is the name of the digital currency, e.g. Bitcoin
+	SymbolName string
+	// Market is the code of the currency in which prices are quoted, e.g. EUR
+	Market string
+	// MarketName is the name of the currency in which prices are quoted
+	MarketName string
+	// LastRefresh is the time the data itself was last updated
+	LastRefresh time.Time
+	// Frequency is the spacing of the elements in the time series
+	Frequency common.Frequency
+	// Interval is the spacing of the elements when Frequency is Intraday
+	Interval intraday.Interval
+	// TimeZone is the time zone of any returned datetime values
+	TimeZone string
+	// DataRange describes the range of data that was returned
+	DataRange *DataRange
+}
+
+// Data Range describes the range of data history
+type DataRange struct {
+	// Start is the start time of the requested data range (earliest)
+	Start time.Time
+	// End is the end time of the requested data range (latest)
+	End time.Time
+}
+
+// Element is an entry in the TimeSeries
+type Element struct {
+	// Timestamp is the time of the data in the element, based on the TZ in the metadata
+	Date time.Time
+	// Data holds the information for the specified types.
+	// USD denominated types are omitted if not provided by Alpha Vantage.
+	Data map[InformationType]float64
+}
+
+// Data is the returned object from a call to GetDaily, GetWeekly, GetMonthly or GetIntraday
+type Data struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// TimeSeries is an ordered set of data
+	TimeSeries []*Element
+}
+
+func (d *Data) isValid() bool {
+	if len(d.TimeSeries) == 0 || d.Meta == nil || len(d.Meta.Information) == 0 {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,277 @@
+package crypto
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+// respJSON captures all possible return JSON.
+// The numbering of the metadata keys differs between functions, so the metadata is
+// captured as a map and values are located by the key suffix.
+type respJSON struct {
+	Info    *string           `json:"Information"`
+	Err     *string           `json:"Error Message"`
+	Meta    map[string]string `json:"Meta Data"`
+	Daily   any               `json:"Time Series (Digital Currency Daily)"`
+	Weekly  any               `json:"Time Series (Digital Currency Weekly)"`
+	Monthly any               `json:"Time Series (Digital Currency Monthly)"`
+	TS1     any               `json:"Time Series Crypto (1min)"`
+	TS5     any               `json:"Time Series Crypto (5min)"`
+	TS15    any               `json:"Time Series Crypto (15min)"`
+	TS30    any               `json:"Time Series Crypto (30min)"`
+	TS60    any               `json:"Time Series Crypto (60min)"`
+}
+
+// GetDaily uses the provided apiKey to retrieve the daily history of the digital currency symbol
+func GetDaily(symbol, apiKey string, opts ...func(*Options) error) (*Data, error) {
+	return getData(symbol, apiKey, common.Daily, opts...)
+}
+
+// GetWeekly uses the provided apiKey to retrieve the weekly history of the digital currency symbol
+func GetWeekly(symbol, apiKey string, opts ...func(*Options) error) (*Data, error) {
+	return getData(symbol, apiKey, common.Weekly, opts...)
+}
+
+// GetMonthly uses the provided apiKey to retrieve the monthly history of the digital currency symbol
+func GetMonthly(symbol, apiKey string, opts ...func(*Options) error) (*Data, error) {
+	return getData(symbol, apiKey, common.Monthly, opts...)
+}
+
+// GetIntraday uses the provided apiKey to retrieve intraday bars for the digital currency symbol,
+// at the interval specified in the options (default: 5min)
+func GetIntraday(symbol, apiKey string, opts ...func(*Options) error) (*Data, error) {
+	return getData(symbol, apiKey, common.Intraday, opts...)
+}
+
+func getData(symbol, apiKey string, frequency common.Frequency, opts ...func(*Options) error) (*Data, error) {
+
+	o := defaultOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	var q *common.Query
+	switch frequency {
+	case common.Intraday:
+		outputsize := "compact"
+		if o.AllAvailableHistory {
+			outputsize = "full"
+		}
+		q = common.NewQuery("CRYPTO_INTRADAY").
+			Set("interval", o.Interval.String()).
+			Set("outputsize", outputsize)
+	case common.Daily:
+		q = common.NewQuery("DIGITAL_CURRENCY_DAILY")
+	case common.Weekly:
+		q = common.NewQuery("DIGITAL_CURRENCY_WEEKLY")
+	case common.Monthly:
+		q = common.NewQuery("DIGITAL_CURRENCY_MONTHLY")
+	default:
+		return nil, common.ErrInvalidInterval
+	}
+
+	url, err := q.Currency("symbol", symbol).
+		Currency("market", o.Market).
+		URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseJSON(b, &o, frequency)
+}
+
+func parseJSON(b []byte, o *Options, frequency common.Frequency) (*Data, error) {
+	var d respJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+
+	result := &Data{
+		Meta:       &Metadata{},
+		TimeSeries: []*Element{},
+	}
+
+	if err := parseMetadata(d.Meta, result, o, frequency); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrMetadataParseError)
+	}
+
+	var ts any
+	switch frequency {
+	case common.Intraday:
+		switch result.Meta.Interval {
+		case intraday.OneMin:
+			ts = d.TS1
+		case intraday.FiveMin:
+			ts = d.TS5
+		case intraday.FifteenMin:
+			ts = d.TS15
+		case intraday.ThirtyMin:
+			ts = d.TS30
+		case intraday.SixtyMin:
+			ts = d.TS60
+		}
+	case common.Daily:
+		ts = d.Daily
+	case common.Weekly:
+		ts = d.Weekly
+	case common.Monthly:
+		ts = d.Monthly
+	}
+
+	if err := parseTimeSeries(ts, result); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrTimeSeriesParseError)
+	}
+
+	return result, nil
+}
+
+func parseMetadata(m map[string]string, r *Data, o *Options, frequency common.Frequency) error {
+	if m == nil {
+		return errors.New("no metadata available to be parsed")
+	}
+
+	im := &Metadata{
+		Information: append([]InformationType{}, o.Information...),
+		Symbol:      common.MetaValue(m, "Digital Currency Code"),
+		SymbolName:  common.MetaValue(m, "Digital Currency Name"),
+		Market:      common.MetaValue(m, "Market Code"),
+		MarketName:  common.MetaValue(m, "Market Name"),
+		Frequency:   frequency,
+		TimeZone:    common.MetaValue(m, "Time Zone"),
+	}
+
+	var err error
+	if frequency == common.Intraday {
+		im.Interval, err = intraday.ParseInterval(common.MetaValue(m, "Interval"))
+		if err != nil {
+			return err
+		}
+	}
+
+	im.LastRefresh, err = common.ParseDateOrIntradayDate(common.MetaValue(m, "Last Refreshed"))
+	if err != nil {
+		return err
+	}
+
+	r.Meta = im
+	return nil
+}
+
+var earliestDate = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+var latestDate = time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)
+
+func parseTimeSeries(i any, r *Data) error {
+
+	if i == nil {
+		return errors.New("no data available to be parsed")
+	}
+
+	tmDataMap, ok := i.(map[string]any)
+	if !ok {
+		return errors.New("provided time series is of the wrong type")
+	}
+
+	tm := []*Element{}
+
+	dtRng := &DataRange{
+		Start: latestDate,
+		End:   earliestDate,
+	}
+
+	for k, v := range tmDataMap {
+		if v == nil {
+			return fmt.Errorf("v is nil for %s", k)
+		}
+
+		ele := &Element{
+			Data: map[InformationType]float64{},
+		}
+
+		t, err := common.ParseDateOrIntradayDate(k)
+		if err != nil {
+			return err
+		}
+		ele.Date = t
+
+		if t.Before(dtRng.Start) {
+			dtRng.Start = t
+		}
+		if t.After(dtRng.End) {
+			dtRng.End = t
+		}
+
+		m, ok := v.(map[string]any)
+		if !ok {
+			return errors.New("failed to extract data from data map")
+		}
+
+		for _, it := range r.Meta.Information {
+			var mv any
+			for _, key := range it.toAVStrings(r.Meta.Market) {
+				if mv, ok = m[key]; ok {
+					break
+				}
+			}
+			if !ok {
+				if it.isUSD() {
+					continue // USD values are not always provided
+				}
+				return fmt.Errorf("missing %s for %s", it, k)
+			}
+
+			s, ok := mv.(string)
+			if !ok {
+				return fmt.Errorf("value of %s for %s is not a string type (%v)", it, k, mv)
+			}
+
+			value, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return fmt.Errorf("error parsing %s (%s) for %s: %v", mv, it, k, err)
+			}
+			ele.Data[it] = value
+		}
+
+		tm = append(tm, ele)
+	}
+
+	// Sort is descending ... most recent date first
+	slices.SortFunc(tm, func(a, b *Element) int {
+		return b.Date.Compare(a.Date)
+	})
+
+	r.TimeSeries = tm
+	r.Meta.DataRange = dtRng
+	return nil
+}
@@ -0,0 +1,119 @@
+package crypto
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+func TestParseJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/btc_eur_daily.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	o := defaultOptions
+	o.Market = "EUR"
+
+	result, err := parseJSON(data, &o, common.Daily)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Symbol != "BTC" || result.Meta.Market != "EUR" {
+		t.Fatalf("unexpected symbol/market: %s/%s", result.Meta.Symbol, result.Meta.Market)
+	}
+
+	if result.Meta.Frequency != common.Daily {
+		t.Fatalf("unexpected frequency: %v", result.Meta.Frequency)
+	}
+
+	if len(result.TimeSeries) != 3 {
+		t.Fatalf("expected 3 elements, got %d", len(result.TimeSeries))
+	}
+
+	latest := result.TimeSeries[0]
+	if latest.Date != time.Date(2025, 8, 26, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("expected first data element to be '2025-08-26', got '%s'", latest.Date)
+	}
+
+	if len(latest.Data) != len(o.Information) {
+		t.Fatalf("expected %d values, got %d", len(o.Information), len(latest.Data))
+	}
+
+	if !common.EqualFloat64(94500, latest.Data[Open], 2) {
+		t.Fatalf("unexpected open: %v", latest.Data[Open])
+	}
+
+	if !common.EqualFloat64(94500*1.1642, latest.Data[OpenUSD], 2) {
+		t.Fatalf("unexpected USD open: %v", latest.Data[OpenUSD])
+	}
+}
+
+func TestParseJSON_1(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/btc_eur_weekly.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	o := defaultOptions
+	o.Market = "EUR"
+
+	result, err := parseJSON(data, &o, common.Weekly)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	latest := result.TimeSeries[0]
+	if !common.EqualFloat64(95110, latest.Data[Close], 2) {
+		t.Fatalf("unexpected close: %v", latest.Data[Close])
+	}
+
+	// USD values are not provided for a EUR market in this format
+	if _, ok := latest.Data[CloseUSD]; ok {
+		t.Fatalf("unexpected USD close: %v", latest.Data[CloseUSD])
+	}
+
+	start := time.Date(2025, 8, 10, 0, 0, 0, 0, time.UTC)
+	if result.Meta.DataRange.Start != start {
+		t.Fatalf("expected data range start %v, got %v", start, result.Meta.DataRange.Start)
+	}
+}
+
+func TestParseJSON_2(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/eth_usd_intraday.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	o := defaultOptions
+
+	result, err := parseJSON(data, &o, common.Intraday)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Interval != intraday.FiveMin {
+		t.Fatalf("unexpected interval: %v", result.Meta.Interval)
+	}
+
+	latest := result.TimeSeries[0]
+	if latest.Date != time.Date(2025, 8, 26, 21, 55, 0, 0, time.UTC) {
+		t.Fatalf("expected first data element at 21:55, got '%s'", latest.Date)
+	}
+
+	// USD market values are also returned as the USD denominated values
+	if latest.Data[Close] != latest.Data[CloseUSD] {
+		t.Fatalf("expected close and USD close to match: %v, %v", latest.Data[Close], latest.Data[CloseUSD])
+	}
+
+	if _, ok := latest.Data[MarketCapUSD]; ok {
+		t.Fatalf("unexpected market cap: %v", latest.Data[MarketCapUSD])
+	}
+}
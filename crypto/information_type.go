@@ -0,0 +1,103 @@
+package crypto
+
+import "fmt"
+
+type InformationType int
+
+const (
+	UnknownInformationType InformationType = iota
+	Open
+	High
+	Low
+	Close
+	Volume
+	OpenUSD
+	HighUSD
+	LowUSD
+	CloseUSD
+	MarketCapUSD
+	InvalidInformationType
+)
+
+func (i InformationType) String() string {
+	switch i {
+	case Open:
+		return "open"
+	case High:
+		return "high"
+	case Low:
+		return "low"
+	case Close:
+		return "close"
+	case Volume:
+		return "volume"
+	case OpenUSD:
+		return "open (USD)"
+	case HighUSD:
+		return "high (USD)"
+	case LowUSD:
+		return "low (USD)"
+	case CloseUSD:
+		return "close (USD)"
+	case MarketCapUSD:
+		return "market cap (USD)"
+	default:
+		panic("invalid value of InformationType")
+	}
+}
+
+func (i InformationType) isValid() bool {
+	if i <= UnknownInformationType || i >= InvalidInformationType {
+		return false
+	}
+	return true
+}
+
+// isUSD returns true if the InformationType is denominated in USD rather than the market currency.
+// These are only provided by Alpha Vantage for some responses, so are optional when parsing.
+func (i InformationType) isUSD() bool {
+	switch i {
+	case OpenUSD, HighUSD, LowUSD, CloseUSD, MarketCapUSD:
+		return true
+	default:
+		return false
+	}
+}
+
+// toAVStrings returns the candidate keys for the InformationType, in order of preference.
+// Alpha Vantage has returned both "1a. open (EUR)" / "1b. open (USD)" pairs and a single "1. open"
+// in the market currency, so both are accepted.
+func (i InformationType) toAVStrings(market string) []string {
+	switch i {
+	case Open:
+		return []string{fmt.Sprintf("1a. open (%s)", market), "1. open"}
+	case High:
+		return []string{fmt.Sprintf("2a. high (%s)", market), "2. high"}
+	case Low:
+		return []string{fmt.Sprintf("3a. low (%s)", market), "3. low"}
+	case Close:
+		return []string{fmt.Sprintf("4a. close (%s)", market), "4. close"}
+	case Volume:
+		return []string{"5. volume"}
+	case OpenUSD:
+		return usdKeys("1b. open (USD)", "1. open", market)
+	case HighUSD:
+		return usdKeys("2b. high (USD)", "2. high", market)
+	case LowUSD:
+		return usdKeys("3b. low (USD)", "3. low", market)
+	case CloseUSD:
+		return usdKeys("4b. close (USD)", "4. close", market)
+	case MarketCapUSD:
+		return []string{"6. market cap (USD)"}
+	default:
+		panic("invalid value of InformationType")
+	}
+}
+
+// usdKeys allows the market values to be used as the USD values when the market is USD
+func usdKeys(usdKey, marketKey, market string) []string {
+	if market == "USD" {
+		return []string{usdKey, marketKey}
+	}
+	return []string{usdKey}
+}
@@ -0,0 +1,85 @@
+package crypto
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+// Options can change the returned Data
+type Options struct {
+	// Information specifies the set of data types to be returned.  Default: all types
+	Information []InformationType
+	// Market is the currency in which prices are quoted.  Default: USD
+	Market string
+	// Interval specifies the interval between elements for intraday time series.  Default: 5min
+	Interval intraday.Interval
+	// AllAvailableHistory = true returns all intraday data; false is 100 records.  Default: false
+	AllAvailableHistory bool
+}
+
+// WithMarket sets the currency in which prices are quoted, e.g. EUR
+func WithMarket(market string) func(*Options) error {
+	return func(o *Options) error {
+		if len(market) == 0 {
+			return errors.New("market must be specified")
+		}
+		o.Market = strings.ToUpper(market)
+		return nil
+	}
+}
+
+// WithInterval sets the interval between elements for intraday time series
+func WithInterval(interval intraday.Interval) func(*Options) error {
+	return func(o *Options) error {
+		if !interval.IsValid() {
+			return common.ErrInvalidInterval
+		}
+		o.Interval = interval
+		return nil
+	}
+}
+
+// WithAllAvailableHistory requests the full intraday history rather than the latest 100 records
+func WithAllAvailableHistory(all bool) func(*Options) error {
+	return func(o *Options) error {
+		o.AllAvailableHistory = all
+		return nil
+	}
+}
+
+// WithInformation sets the information types to be returned
+// If no information types are specified, all types are returned.
+func WithInformation(information ...InformationType) func(*Options) error {
+	return func(o *Options) error {
+		for _, i := range information {
+			if !i.isValid() {
+				return common.ErrInvalidInformationType
+			}
+		}
+		if len(information) > 0 {
+			o.Information = append([]InformationType{}, information...)
+		}
+		return nil
+	}
+}
+
+var defaultOptions = Options{
+	Information: []InformationType{
+		Open,
+		High,
+		Low,
+		Close,
+		Volume,
+		OpenUSD,
+		HighUSD,
+		LowUSD,
+		CloseUSD,
+		MarketCapUSD,
+	},
+	Market:              "USD",
+	Interval:            intraday.FiveMin,
+	AllAvailableHistory: false,
+}
@@ -0,0 +1,47 @@
+package economic
+
+import (
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Indicator is the requested indicator
+	Indicator Indicator
+	// Name is the description of the series provided by Alpha Vantage
+	Name string
+	// Interval is the spacing of the elements in the time series
+	Interval common.Frequency
+	// Unit describes the unit of the values, e.g. "percent"
+	Unit string
+	// Maturity is the bond maturity, set only for TreasuryYield
+	Maturity Maturity
+	// DataRange describes the range of data that was returned
+	DataRange *DataRange
+}
+
+// Data Range describes the range of data history
+type DataRange struct {
+	// Start is the start time of the requested data range (earliest)
+	Start time.Time
+	// End is the end time of the requested data range (latest)
+	End time.Time
+}
+
+// Element is an entry in the TimeSeries
+type Element struct {
+	// Date is the date of the value
+	Date time.Time
+	// Value is the value of the indicator, undefined where Alpha Vantage has no value for the date
+	Value common.OptionalFloat64
+}
+
+// Data is the returned object from a call to GetData
+type Data struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// TimeSeries is an ordered set of data
+	TimeSeries []*Element
+}
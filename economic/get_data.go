@@ -0,0 +1,176 @@
+package economic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// respJSON captures all possible return JSON
+type respJSON struct {
+	Info     *string          `json:"Information"`
+	Err      *string          `json:"Error Message"`
+	Name     string           `json:"name"`
+	Interval string           `json:"interval"`
+	Unit     string           `json:"unit"`
+	Data     *[]*respDataJSON `json:"data"`
+}
+
+type respDataJSON struct {
+	Date  string `json:"date"`
+	Value string `json:"value"`
+}
+
+// ErrInvalidIndicator returned when an invalid indicator is specified
+var ErrInvalidIndicator = errors.New("invalid indicator specified")
+
+// GetData uses the provided apiKey to retrieve the history of the economic indicator
+func GetData(indicator Indicator, apiKey string, opts ...func(*Options) error) (*Data, error) {
+
+	if !indicator.IsValid() {
+		return nil, ErrInvalidIndicator
+	}
+
+	o := defaultOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	if o.Interval == common.UnknownFrequency {
+		o.Interval = indicator.intervals()[0]
+	}
+	if !indicator.supportsInterval(o.Interval) {
+		return nil, fmt.Errorf("%s is not available for %s: %w", o.Interval, indicator, common.ErrInvalidInterval)
+	}
+
+	q := common.NewQuery(indicator.toAVString())
+	if len(indicator.intervals()) > 1 {
+		q.Set("interval", o.Interval.String())
+	}
+	if indicator == TreasuryYield {
+		q.Set("maturity", o.Maturity.String())
+	}
+
+	url, err := q.URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseJSON(b, indicator, &o)
+}
+
+func parseJSON(b []byte, indicator Indicator, o *Options) (*Data, error) {
+	var d respJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+
+	interval, err := common.ParseFrequency(d.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrMetadataParseError)
+	}
+
+	result := &Data{
+		Meta: &Metadata{
+			Indicator: indicator,
+			Name:      d.Name,
+			Interval:  interval,
+			Unit:      d.Unit,
+		},
+		TimeSeries: []*Element{},
+	}
+
+	if indicator == TreasuryYield {
+		result.Meta.Maturity = o.Maturity
+	}
+
+	if err := parseTimeSeries(d.Data, result); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrTimeSeriesParseError)
+	}
+
+	return result, nil
+}
+
+var earliestDate = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+var latestDate = time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)
+
+func parseTimeSeries(d *[]*respDataJSON, r *Data) error {
+
+	if d == nil || len(*d) == 0 {
+		return errors.New("no data available to be parsed")
+	}
+
+	ts := []*Element{}
+
+	dtRng := &DataRange{
+		Start: latestDate,
+		End:   earliestDate,
+	}
+
+	for i, v := range *d {
+		if v == nil {
+			return fmt.Errorf("v is nil for element %d", i)
+		}
+
+		t, err := common.ParseDate(v.Date)
+		if err != nil {
+			return err
+		}
+
+		if t.Before(dtRng.Start) {
+			dtRng.Start = t
+		}
+		if t.After(dtRng.End) {
+			dtRng.End = t
+		}
+
+		// Missing values are reported as "."
+		value, err := common.ParseOptionalFloat64(v.Value)
+		if err != nil {
+			return fmt.Errorf("error parsing value (%s) for %s: %v", v.Value, v.Date, err)
+		}
+
+		ts = append(ts, &Element{
+			Date:  t,
+			Value: value,
+		})
+	}
+
+	// Sort is descending ... most recent date first
+	slices.SortFunc(ts, func(a, b *Element) int {
+		return b.Date.Compare(a.Date)
+	})
+
+	r.TimeSeries = ts
+	r.Meta.DataRange = dtRng
+	return nil
+}
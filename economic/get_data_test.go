@@ -0,0 +1,99 @@
+package economic
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestParseJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/real_gdp_quarterly.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	o := defaultOptions
+
+	result, err := parseJSON(data, RealGDP, &o)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Interval != common.Quarterly {
+		t.Fatalf("expected quarterly interval, got %v", result.Meta.Interval)
+	}
+
+	if result.Meta.Unit != "billions of dollars" {
+		t.Fatalf("unexpected unit: %s", result.Meta.Unit)
+	}
+
+	if result.Meta.Maturity != UnknownMaturity {
+		t.Fatalf("unexpected maturity: %v", result.Meta.Maturity)
+	}
+
+	if len(result.TimeSeries) != 4 {
+		t.Fatalf("expected 4 elements, got %d", len(result.TimeSeries))
+	}
+
+	if result.Meta.DataRange.Start != time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected data range start: %v", result.Meta.DataRange.Start)
+	}
+
+	if !common.EqualFloat64(5934.127, result.TimeSeries[0].Value.Value, 3) {
+		t.Fatalf("unexpected first value: %v", result.TimeSeries[0].Value)
+	}
+}
+
+func TestParseJSON_1(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/treasury_yield_daily.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	o := defaultOptions
+
+	result, err := parseJSON(data, TreasuryYield, &o)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Interval != common.Daily {
+		t.Fatalf("expected daily interval, got %v", result.Meta.Interval)
+	}
+
+	if result.Meta.Maturity != TenYear {
+		t.Fatalf("expected 10 year maturity, got %v", result.Meta.Maturity)
+	}
+
+	if !result.TimeSeries[4].Value.IsUndefined() {
+		t.Fatalf("expected missing value for 2025-08-19, got %v", result.TimeSeries[4].Value)
+	}
+}
+
+func TestGetData(t *testing.T) {
+
+	// Invalid requests are rejected before any remote call is made
+	if _, err := GetData(Inflation, "KEY", WithInterval(common.Monthly)); err == nil {
+		t.Fatal("expected error for unsupported interval, got nil")
+	}
+
+	if _, err := GetData(InvalidIndicator, "KEY"); err != ErrInvalidIndicator {
+		t.Fatalf("unexpected error: expected %v, got %v", ErrInvalidIndicator, err)
+	}
+}
+
+func TestIndicator(t *testing.T) {
+
+	for i := UnknownIndicator + 1; i < InvalidIndicator; i++ {
+		if i.String() == "" || i.toAVString() == "" {
+			t.Fatalf("missing description for %d", i)
+		}
+		if !i.supportsInterval(i.intervals()[0]) {
+			t.Fatalf("default interval not supported for %s", i)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package economic
+
+import (
+	"slices"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+type Indicator int
+
+const (
+	UnknownIndicator Indicator = iota
+	RealGDP
+	RealGDPPerCapita
+	TreasuryYield
+	FederalFundsRate
+	CPI
+	Inflation
+	RetailSales
+	Durables
+	Unemployment
+	NonfarmPayroll
+	InvalidIndicator
+)
+
+func (i Indicator) String() string {
+	switch i {
+	case RealGDP:
+		return "real GDP"
+	case RealGDPPerCapita:
+		return "real GDP per capita"
+	case TreasuryYield:
+		return "treasury yield"
+	case FederalFundsRate:
+		return "federal funds rate"
+	case CPI:
+		return "consumer price index"
+	case Inflation:
+		return "inflation"
+	case RetailSales:
+		return "retail sales"
+	case Durables:
+		return "durable goods orders"
+	case Unemployment:
+		return "unemployment rate"
+	case NonfarmPayroll:
+		return "nonfarm payroll"
+	default:
+		panic("invalid value of Indicator")
+	}
+}
+
+// Function returns the Alpha Vantage function that retrieves the indicator.  Panics if the Indicator is invalid.
+func (i Indicator) Function() string {
+	return i.toAVString()
+}
+
+func (i Indicator) toAVString() string {
+	switch i {
+	case RealGDP:
+		return "REAL_GDP"
+	case RealGDPPerCapita:
+		return "REAL_GDP_PER_CAPITA"
+	case TreasuryYield:
+		return "TREASURY_YIELD"
+	case FederalFundsRate:
+		return "FEDERAL_FUNDS_RATE"
+	case CPI:
+		return "CPI"
+	case Inflation:
+		return "INFLATION"
+	case RetailSales:
+		return "RETAIL_SALES"
+	case Durables:
+		return "DURABLES"
+	case Unemployment:
+		return "UNEMPLOYMENT"
+	case NonfarmPayroll:
+		return "NONFARM_PAYROLL"
+	default:
+		panic("invalid value of Indicator")
+	}
+}
+
+// IsValid returns true if the Indicator can be used in a request
+func (i Indicator) IsValid() bool {
+	if i <= UnknownIndicator || i >= InvalidIndicator {
+		return false
+	}
+	return true
+}
+
+// intervals returns the intervals Alpha Vantage provides for the indicator, with the default first.
+// Indicators with a single interval do not accept the interval parameter.
+func (i Indicator) intervals() []common.Frequency {
+	switch i {
+	case RealGDP:
+		return []common.Frequency{common.Annual, common.Quarterly}
+	case RealGDPPerCapita:
+		return []common.Frequency{common.Quarterly}
+	case TreasuryYield, FederalFundsRate:
+		return []common.Frequency{common.Monthly, common.Weekly, common.Daily}
+	case CPI:
+		return []common.Frequency{common.Monthly, common.SemiAnnual}
+	case Inflation:
+		return []common.Frequency{common.Annual}
+	case RetailSales, Durables, Unemployment, NonfarmPayroll:
+		return []common.Frequency{common.Monthly}
+	default:
+		panic("invalid value of Indicator")
+	}
+}
+
+// supportsInterval returns true if Alpha Vantage provides the indicator at the specified interval
+func (i Indicator) supportsInterval(f common.Frequency) bool {
+	return slices.Contains(i.intervals(), f)
+}
@@ -0,0 +1,40 @@
+package economic
+
+type Maturity int
+
+const (
+	UnknownMaturity Maturity = iota
+	ThreeMonth
+	TwoYear
+	FiveYear
+	SevenYear
+	TenYear
+	ThirtyYear
+	InvalidMaturity
+)
+
+func (m Maturity) String() string {
+	switch m {
+	case ThreeMonth:
+		return "3month"
+	case TwoYear:
+		return "2year"
+	case FiveYear:
+		return "5year"
+	case SevenYear:
+		return "7year"
+	case TenYear:
+		return "10year"
+	case ThirtyYear:
+		return "30year"
+	default:
+		panic("invalid value of Maturity")
+	}
+}
+
+func (m Maturity) isValid() bool {
+	if m <= UnknownMaturity || m >= InvalidMaturity {
+		return false
+	}
+	return true
+}
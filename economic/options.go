@@ -0,0 +1,42 @@
+package economic
+
+import (
+	"errors"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// Options can change the returned Data from GetData
+type Options struct {
+	// Interval specifies the interval between time series elements.  Default: the indicator's default interval
+	Interval common.Frequency
+	// Maturity specifies the bond maturity for TreasuryYield, and is ignored for other indicators.  Default: 10 year
+	Maturity Maturity
+}
+
+// WithInterval sets the interval between elements, which must be supported by the indicator
+func WithInterval(interval common.Frequency) func(*Options) error {
+	return func(o *Options) error {
+		if !interval.IsValid() || interval == common.Intraday {
+			return common.ErrInvalidInterval
+		}
+		o.Interval = interval
+		return nil
+	}
+}
+
+// WithMaturity sets the bond maturity for TreasuryYield
+func WithMaturity(maturity Maturity) func(*Options) error {
+	return func(o *Options) error {
+		if !maturity.isValid() {
+			return errors.New("invalid maturity")
+		}
+		o.Maturity = maturity
+		return nil
+	}
+}
+
+var defaultOptions = Options{
+	Interval: common.UnknownFrequency,
+	Maturity: TenYear,
+}
@@ -0,0 +1,79 @@
+package etf
+
+import (
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/listing"
+)
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Symbol is the requested ETF symbol
+	Symbol string
+}
+
+// SectorWeight is the allocation of the ETF to a sector
+type SectorWeight struct {
+	// Sector is the name of the sector, e.g. "INFORMATION TECHNOLOGY"
+	Sector string
+	// Weight is the fraction of the ETF allocated to the sector, e.g. 0.25 is 25%
+	Weight float64
+}
+
+// Holding is a constituent of the ETF
+type Holding struct {
+	// Symbol is the symbol of the constituent, which is empty if not provided (e.g. for cash)
+	Symbol listing.Symbol
+	// Description is the name of the constituent
+	Description string
+	// Weight is the fraction of the ETF held in the constituent, e.g. 0.05 is 5%
+	Weight float64
+}
+
+// Data is the returned object from a call to GetProfile
+type Data struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// NetAssets is the net assets of the ETF
+	NetAssets common.OptionalFloat64
+	// NetExpenseRatio is the annual expense ratio, e.g. 0.002 is 0.2%
+	NetExpenseRatio common.OptionalFloat64
+	// PortfolioTurnover is the annual portfolio turnover, e.g. 0.08 is 8%
+	PortfolioTurnover common.OptionalFloat64
+	// DividendYield is the dividend yield, e.g. 0.01 is 1%
+	DividendYield common.OptionalFloat64
+	// InceptionDate is the date the ETF was launched
+	InceptionDate common.OptionalDate
+	// Leveraged is true if the ETF uses leverage
+	Leveraged bool
+	// Sectors lists the sector allocations, largest first
+	Sectors []*SectorWeight
+	// Holdings lists the constituents, largest first
+	Holdings []*Holding
+}
+
+// ResolvedHolding pairs a Holding with its entry in a listing
+type ResolvedHolding struct {
+	// Holding is the constituent of the ETF
+	Holding *Holding
+	// Info is the listing entry for the constituent
+	Info *listing.Info
+}
+
+// ResolveHoldings looks up each of the Holdings in the listing, so that the ETF can be looked through into its
+// underlying tradeables.  Holdings that are not present in the listing (e.g. cash, or non-US constituents)
+// are returned separately, in the same order as Holdings.
+func (d *Data) ResolveHoldings(l *listing.Data) (resolved []*ResolvedHolding, unresolved []*Holding) {
+	resolved = []*ResolvedHolding{}
+	unresolved = []*Holding{}
+	for _, h := range d.Holdings {
+		if h.Symbol != "" && l.Contains(h.Symbol) {
+			resolved = append(resolved, &ResolvedHolding{
+				Holding: h,
+				Info:    l.Tradeables[h.Symbol],
+			})
+			continue
+		}
+		unresolved = append(unresolved, h)
+	}
+	return resolved, unresolved
+}
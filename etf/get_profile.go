@@ -0,0 +1,149 @@
+package etf
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/listing"
+)
+
+// respJSON captures all possible return JSON
+type respJSON struct {
+	Info              *string             `json:"Information"`
+	Err               *string             `json:"Error Message"`
+	NetAssets         string              `json:"net_assets"`
+	NetExpenseRatio   string              `json:"net_expense_ratio"`
+	PortfolioTurnover string              `json:"portfolio_turnover"`
+	DividendYield     string              `json:"dividend_yield"`
+	InceptionDate     string              `json:"inception_date"`
+	Leveraged         string              `json:"leveraged"`
+	Sectors           *[]*respSectorJSON  `json:"sectors"`
+	Holdings          *[]*respHoldingJSON `json:"holdings"`
+}
+
+type respSectorJSON struct {
+	Sector string `json:"sector"`
+	Weight string `json:"weight"`
+}
+
+type respHoldingJSON struct {
+	Symbol      string `json:"symbol"`
+	Description string `json:"description"`
+	Weight      string `json:"weight"`
+}
+
+// GetProfile uses the provided apiKey to retrieve the profile and holdings of the ETF
+func GetProfile(symbol, apiKey string) (*Data, error) {
+
+	url, err := common.NewQuery("ETF_PROFILE").Symbol(symbol).URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseProfileJSON(b, strings.ToUpper(symbol))
+}
+
+func parseProfileJSON(b []byte, symbol string) (*Data, error) {
+	var d respJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+	if d.Holdings == nil {
+		return nil, fmt.Errorf("no holdings available to be parsed: %w", common.ErrParseError)
+	}
+
+	result := &Data{
+		Meta: &Metadata{
+			Symbol: symbol,
+		},
+		Leveraged: strings.EqualFold(d.Leveraged, "YES"),
+		Sectors:   []*SectorWeight{},
+		Holdings:  []*Holding{},
+	}
+
+	var err error
+	for _, v := range []struct {
+		name  string
+		s     string
+		value *common.OptionalFloat64
+	}{
+		{"net_assets", d.NetAssets, &result.NetAssets},
+		{"net_expense_ratio", d.NetExpenseRatio, &result.NetExpenseRatio},
+		{"portfolio_turnover", d.PortfolioTurnover, &result.PortfolioTurnover},
+		{"dividend_yield", d.DividendYield, &result.DividendYield},
+	} {
+		if *v.value, err = common.ParseOptionalFloat64(v.s); err != nil {
+			return nil, fmt.Errorf("invalid %s %s: %w", v.name, v.s, common.ErrParseError)
+		}
+	}
+
+	if result.InceptionDate, err = common.ParseOptionalDate(d.InceptionDate); err != nil {
+		return nil, fmt.Errorf("invalid inception_date %s: %w", d.InceptionDate, common.ErrParseError)
+	}
+
+	if d.Sectors != nil {
+		for _, s := range *d.Sectors {
+			w, err := strconv.ParseFloat(s.Weight, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid weight %s for sector %s: %w", s.Weight, s.Sector, common.ErrParseError)
+			}
+			result.Sectors = append(result.Sectors, &SectorWeight{
+				Sector: s.Sector,
+				Weight: w,
+			})
+		}
+	}
+
+	for _, h := range *d.Holdings {
+		w, err := strconv.ParseFloat(h.Weight, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight %s for holding %s: %w", h.Weight, h.Description, common.ErrParseError)
+		}
+		holding := &Holding{
+			Description: h.Description,
+			Weight:      w,
+		}
+		if !common.IsMissing(h.Symbol) {
+			holding.Symbol = listing.Symbol(h.Symbol)
+		}
+		result.Holdings = append(result.Holdings, holding)
+	}
+
+	// Sort is descending ... largest weight first
+	slices.SortStableFunc(result.Sectors, func(a, b *SectorWeight) int {
+		return cmp.Compare(b.Weight, a.Weight)
+	})
+	slices.SortStableFunc(result.Holdings, func(a, b *Holding) int {
+		return cmp.Compare(b.Weight, a.Weight)
+	})
+
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package etf
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/listing"
+)
+
+func TestParseProfileJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/qqq_etf_profile.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseProfileJSON(data, "QQQ")
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if !common.EqualFloat64(0.002, result.NetExpenseRatio.Value, 3) || result.Leveraged {
+		t.Fatalf("unexpected profile: %v", result)
+	}
+
+	if result.InceptionDate.Value != time.Date(1999, 3, 10, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected inception date: %v", result.InceptionDate)
+	}
+
+	if len(result.Sectors) != 3 || result.Sectors[0].Sector != "INFORMATION TECHNOLOGY" {
+		t.Fatalf("unexpected sectors: %v", result.Sectors)
+	}
+
+	if len(result.Holdings) != 5 || result.Holdings[0].Symbol != "NVDA" || result.Holdings[3].Symbol != "ASML" {
+		t.Fatalf("unexpected holdings: %v", result.Holdings)
+	}
+
+	if result.Holdings[4].Symbol != "" || result.Holdings[4].Description != "CASH" {
+		t.Fatalf("unexpected cash holding: %v", result.Holdings[4])
+	}
+}
+
+func TestResolveHoldings(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/qqq_etf_profile.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseProfileJSON(data, "QQQ")
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	l := &listing.Data{
+		Tradeables: map[listing.Symbol]*listing.Info{
+			"AAPL": {Symbol: "AAPL", Name: "Apple Inc", Exchange: "NASDAQ", Type: listing.Stock},
+			"MSFT": {Symbol: "MSFT", Name: "Microsoft Corporation", Exchange: "NASDAQ", Type: listing.Stock},
+			"NVDA": {Symbol: "NVDA", Name: "NVIDIA Corp", Exchange: "NASDAQ", Type: listing.Stock},
+		},
+	}
+
+	resolved, unresolved := result.ResolveHoldings(l)
+
+	if len(resolved) != 3 || len(unresolved) != 2 {
+		t.Fatalf("unexpected resolution: %d resolved, %d unresolved", len(resolved), len(unresolved))
+	}
+
+	if resolved[0].Info.Name != "NVIDIA Corp" || resolved[0].Holding.Symbol != "NVDA" {
+		t.Fatalf("unexpected first resolved holding: %v", resolved[0])
+	}
+
+	if unresolved[0].Symbol != "ASML" {
+		t.Fatalf("unexpected first unresolved holding: %v", unresolved[0])
+	}
+}
@@ -0,0 +1,318 @@
+package fundamentals
+
+import (
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/listing"
+)
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Symbol is the requested symbol for which data is retrieved
+	Symbol string
+	// LastRefresh is the end of the latest reported period included in the data
+	LastRefresh time.Time
+}
+
+// Overview is the returned object from a call to GetOverview.
+// Values not provided by Alpha Vantage (reported as "None" or "-") are undefined rather than zero.
+type Overview struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+
+	// Name is the company name
+	Name string
+	// Description is a summary of the company's business
+	Description string
+	// AssetType is the type of the security, such as "Common Stock"
+	AssetType string
+	// CIK is the SEC Central Index Key of the company
+	CIK string
+	// Exchange is the primary exchange of the security
+	Exchange string
+	// Currency is the currency in which the security is traded
+	Currency string
+	// Country is the country of domicile
+	Country string
+	// Sector is the business sector of the company
+	Sector string
+	// Industry is the industry of the company within its sector
+	Industry string
+	// Address is the registered address of the company
+	Address string
+	// OfficialSite is the company's web site
+	OfficialSite string
+	// FiscalYearEnd is the month in which the company's fiscal year ends
+	FiscalYearEnd string
+	// LatestQuarter is the end date of the most recently reported quarter
+	LatestQuarter common.OptionalDate
+
+	// MarketCapitalization is the market value of the outstanding shares
+	MarketCapitalization common.OptionalFloat64
+	// EBITDA is earnings before interest, tax, depreciation and amortisation
+	EBITDA common.OptionalFloat64
+	// PERatio is the price to earnings ratio
+	PERatio common.OptionalFloat64
+	// PEGRatio is the price to earnings ratio divided by earnings growth
+	PEGRatio common.OptionalFloat64
+	// BookValue is the book value per share
+	BookValue common.OptionalFloat64
+	// DividendPerShare is the annual dividend per share
+	DividendPerShare common.OptionalFloat64
+	// DividendYield is the annual dividend as a fraction of the share price
+	DividendYield common.OptionalFloat64
+	// EPS is earnings per share
+	EPS common.OptionalFloat64
+	// RevenuePerShareTTM is the trailing twelve month revenue per share
+	RevenuePerShareTTM common.OptionalFloat64
+	// ProfitMargin is net income as a fraction of revenue
+	ProfitMargin common.OptionalFloat64
+	// OperatingMarginTTM is the trailing twelve month operating income as a fraction of revenue
+	OperatingMarginTTM common.OptionalFloat64
+	// ReturnOnAssetsTTM is the trailing twelve month net income as a fraction of total assets
+	ReturnOnAssetsTTM common.OptionalFloat64
+	// ReturnOnEquityTTM is the trailing twelve month net income as a fraction of shareholder equity
+	ReturnOnEquityTTM common.OptionalFloat64
+	// RevenueTTM is the trailing twelve month revenue
+	RevenueTTM common.OptionalFloat64
+	// GrossProfitTTM is the trailing twelve month gross profit
+	GrossProfitTTM common.OptionalFloat64
+	// DilutedEPSTTM is the trailing twelve month diluted earnings per share
+	DilutedEPSTTM common.OptionalFloat64
+	// QuarterlyEarningsGrowthYOY is the year on year growth of the latest quarter's earnings
+	QuarterlyEarningsGrowthYOY common.OptionalFloat64
+	// QuarterlyRevenueGrowthYOY is the year on year growth of the latest quarter's revenue
+	QuarterlyRevenueGrowthYOY common.OptionalFloat64
+	// AnalystTargetPrice is the consensus analyst price target
+	AnalystTargetPrice common.OptionalFloat64
+	// AnalystRatingStrongBuy is the number of analysts rating the stock a strong buy
+	AnalystRatingStrongBuy common.OptionalFloat64
+	// AnalystRatingBuy is the number of analysts rating the stock a buy
+	AnalystRatingBuy common.OptionalFloat64
+	// AnalystRatingHold is the number of analysts rating the stock a hold
+	AnalystRatingHold common.OptionalFloat64
+	// AnalystRatingSell is the number of analysts rating the stock a sell
+	AnalystRatingSell common.OptionalFloat64
+	// AnalystRatingStrongSell is the number of analysts rating the stock a strong sell
+	AnalystRatingStrongSell common.OptionalFloat64
+	// TrailingPE is the price to trailing twelve month earnings ratio
+	TrailingPE common.OptionalFloat64
+	// ForwardPE is the price to forecast earnings ratio
+	ForwardPE common.OptionalFloat64
+	// PriceToSalesRatioTTM is the price to trailing twelve month revenue per share ratio
+	PriceToSalesRatioTTM common.OptionalFloat64
+	// PriceToBookRatio is the price to book value per share ratio
+	PriceToBookRatio common.OptionalFloat64
+	// EVToRevenue is the enterprise value to revenue ratio
+	EVToRevenue common.OptionalFloat64
+	// EVToEBITDA is the enterprise value to EBITDA ratio
+	EVToEBITDA common.OptionalFloat64
+	// Beta is the volatility of the stock relative to the market
+	Beta common.OptionalFloat64
+	// FiftyTwoWeekHigh is the highest price in the last 52 weeks
+	FiftyTwoWeekHigh common.OptionalFloat64
+	// FiftyTwoWeekLow is the lowest price in the last 52 weeks
+	FiftyTwoWeekLow common.OptionalFloat64
+	// FiftyDayMovingAverage is the mean price over the last 50 trading days
+	FiftyDayMovingAverage common.OptionalFloat64
+	// TwoHundredDayMovingAverage is the mean price over the last 200 trading days
+	TwoHundredDayMovingAverage common.OptionalFloat64
+	// SharesOutstanding is the number of shares in issue
+	SharesOutstanding common.OptionalFloat64
+	// SharesFloat is the number of shares available for public trading
+	SharesFloat common.OptionalFloat64
+	// PercentInsiders is the percentage of shares held by insiders
+	PercentInsiders common.OptionalFloat64
+	// PercentInstitutions is the percentage of shares held by institutions
+	PercentInstitutions common.OptionalFloat64
+
+	// DividendDate is the payment date of the latest dividend
+	DividendDate common.OptionalDate
+	// ExDividendDate is the ex-dividend date of the latest dividend
+	ExDividendDate common.OptionalDate
+}
+
+// Report is a single set of reported values within a Statement
+type Report struct {
+	// FiscalDateEnding is the end date of the period covered by the report
+	FiscalDateEnding time.Time
+	// ReportedCurrency is the currency of the monetary values in the report
+	ReportedCurrency string
+	// Data holds a value for every LineItem of the statement, undefined if not reported
+	Data map[LineItem]common.OptionalFloat64
+}
+
+// Statement is the returned object from a call to GetStatement
+type Statement struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// Type is the type of financial statement
+	Type StatementType
+	// AnnualReports is an ordered set of annual reports, most recent first
+	AnnualReports []*Report
+	// QuarterlyReports is an ordered set of quarterly reports, most recent first
+	QuarterlyReports []*Report
+}
+
+// Reports returns the ordered reports for the specified Period
+func (s *Statement) Reports(p Period) []*Report {
+	switch p {
+	case Annual:
+		return s.AnnualReports
+	case Quarterly:
+		return s.QuarterlyReports
+	default:
+		return nil
+	}
+}
+
+// DefaultReportingLag is a conservative delay between the end of a fiscal period and the publication
+// of its figures, being the longest period allowed for the filing of an annual report by US companies
+const DefaultReportingLag = 90 * 24 * time.Hour
+
+// AsOf returns the most recent report for the Period that would have been published by the specified date,
+// assuming that each report is published lag after its FiscalDateEnding, or nil if there is no such report.
+// Figures are not known at the FiscalDateEnding, so a lag is required to avoid look-ahead bias
+// when joining statement values to price histories by date; use AsReported where Earnings are available.
+func (s *Statement) AsOf(date time.Time, p Period, lag time.Duration) *Report {
+	for _, r := range s.Reports(p) {
+		if !r.FiscalDateEnding.Add(lag).After(date) {
+			return r
+		}
+	}
+	return nil
+}
+
+// AsReported returns the most recent report for the Period that had been published by the specified date,
+// using the ReportedDate of the quarterly Earnings with the same FiscalDateEnding, or nil if there is no such report.
+// Reports without a known ReportedDate are ignored.
+func (s *Statement) AsReported(date time.Time, p Period, earnings *Earnings) *Report {
+	for _, r := range s.Reports(p) {
+		reported, ok := earnings.reportedDate(r.FiscalDateEnding)
+		if ok && !reported.After(date) {
+			return r
+		}
+	}
+	return nil
+}
+
+// EarningsElement is a single set of reported earnings within Earnings
+type EarningsElement struct {
+	// FiscalDateEnding is the end date of the period covered by the earnings
+	FiscalDateEnding time.Time
+	// ReportedDate is the date the earnings were announced (quarterly earnings only)
+	ReportedDate common.OptionalDate
+	// ReportTime describes when on the ReportedDate the announcement was made, e.g. "post-market" (quarterly earnings only)
+	ReportTime string
+	// ReportedEPS is the reported earnings per share
+	ReportedEPS common.OptionalFloat64
+	// EstimatedEPS is the consensus estimate of earnings per share (quarterly earnings only)
+	EstimatedEPS common.OptionalFloat64
+	// Surprise is ReportedEPS less EstimatedEPS (quarterly earnings only)
+	Surprise common.OptionalFloat64
+	// SurprisePercentage is Surprise as a percentage of EstimatedEPS (quarterly earnings only)
+	SurprisePercentage common.OptionalFloat64
+}
+
+// Earnings is the returned object from a call to GetEarnings
+type Earnings struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// AnnualEarnings is an ordered set of annual earnings, most recent first
+	AnnualEarnings []*EarningsElement
+	// QuarterlyEarnings is an ordered set of quarterly earnings, most recent first
+	QuarterlyEarnings []*EarningsElement
+}
+
+// reportedDate returns the date on which the figures for the fiscal period ending on the date were announced
+func (e *Earnings) reportedDate(fiscalDateEnding time.Time) (time.Time, bool) {
+	for _, q := range e.QuarterlyEarnings {
+		if q.FiscalDateEnding.Equal(fiscalDateEnding) && !q.ReportedDate.IsUndefined() {
+			return q.ReportedDate.Value, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// InUniverse returns true if the symbol of the Earnings is one of the Tradeables in the universe
+func (e *Earnings) InUniverse(universe *listing.Data) bool {
+	return universe.Contains(listing.Symbol(e.Meta.Symbol))
+}
+
+// CalendarMetadata describes how the request was made
+type CalendarMetadata struct {
+	// Options describes the options used
+	Options *CalendarOptions
+}
+
+// CalendarEntry is a single expected earnings announcement
+type CalendarEntry struct {
+	// Symbol is the symbol of the reporting company
+	Symbol listing.Symbol
+	// Name is the name of the reporting company
+	Name string
+	// ReportDate is the expected date of the announcement
+	ReportDate time.Time
+	// FiscalDateEnding is the end date of the period being reported
+	FiscalDateEnding time.Time
+	// Estimate is the consensus estimate of earnings per share
+	Estimate common.OptionalFloat64
+	// Currency is the currency of the Estimate
+	Currency string
+}
+
+// EarningsCalendar is the returned object from a call to GetEarningsCalendar
+type EarningsCalendar struct {
+	// Meta describes the details of the data
+	Meta *CalendarMetadata
+	// Entries is the set of expected announcements, ordered by ReportDate (earliest first)
+	Entries []*CalendarEntry
+}
+
+// Filter returns a new EarningsCalendar containing only the entries whose symbols are Tradeables in the universe
+func (c *EarningsCalendar) Filter(universe *listing.Data) *EarningsCalendar {
+	result := &EarningsCalendar{
+		Meta:    c.Meta,
+		Entries: []*CalendarEntry{},
+	}
+	for _, e := range c.Entries {
+		if universe.Contains(e.Symbol) {
+			result.Entries = append(result.Entries, e)
+		}
+	}
+	return result
+}
+
+// FilterEarnings returns the subset of earnings whose symbols are Tradeables in the universe
+func FilterEarnings(earnings []*Earnings, universe *listing.Data) []*Earnings {
+	result := []*Earnings{}
+	for _, e := range earnings {
+		if e != nil && e.InUniverse(universe) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// SpeakerTurn is a single contribution to an earnings call
+type SpeakerTurn struct {
+	// Speaker is the name of the speaker
+	Speaker string
+	// Title is the role of the speaker, e.g. "Chief Financial Officer"
+	Title string
+	// Content is what was said
+	Content string
+	// Sentiment is between -1 (negative) and 1 (positive), undefined if not provided
+	Sentiment common.OptionalFloat64
+}
+
+// Transcript is the returned object from a call to GetTranscript
+type Transcript struct {
+	// Symbol is the requested symbol
+	Symbol string
+	// Quarter is the fiscal quarter of the call, e.g. "2024Q1"
+	Quarter string
+	// Turns lists the contributions to the call, in the order they were made
+	Turns []*SpeakerTurn
+}
@@ -0,0 +1,141 @@
+package fundamentals
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// respEarningsJSON captures all possible return JSON
+type respEarningsJSON struct {
+	Info      *string              `json:"Information"`
+	Err       *string              `json:"Error Message"`
+	Symbol    *string              `json:"symbol"`
+	Annual    *[]map[string]string `json:"annualEarnings"`
+	Quarterly *[]map[string]string `json:"quarterlyEarnings"`
+}
+
+// GetEarnings uses the provided apiKey to retrieve the annual and quarterly earnings history for the symbol
+func GetEarnings(symbol, apiKey string) (*Earnings, error) {
+
+	url, err := common.NewQuery("EARNINGS").Symbol(symbol).URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseEarningsJSON(b)
+}
+
+func parseEarningsJSON(b []byte) (*Earnings, error) {
+	var d respEarningsJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+	if d.Symbol == nil {
+		return nil, fmt.Errorf("api error: expected Symbol, got nil: %w", common.ErrRemoteCallError)
+	}
+
+	result := &Earnings{
+		Meta: &Metadata{
+			Symbol: *d.Symbol,
+		},
+	}
+
+	var err error
+	result.AnnualEarnings, err = parseEarningsElements(d.Annual)
+	if err != nil {
+		return nil, fmt.Errorf("annual earnings: %v: %w", err, common.ErrTimeSeriesParseError)
+	}
+
+	result.QuarterlyEarnings, err = parseEarningsElements(d.Quarterly)
+	if err != nil {
+		return nil, fmt.Errorf("quarterly earnings: %v: %w", err, common.ErrTimeSeriesParseError)
+	}
+
+	for _, elements := range [][]*EarningsElement{result.AnnualEarnings, result.QuarterlyEarnings} {
+		if len(elements) > 0 && elements[0].FiscalDateEnding.After(result.Meta.LastRefresh) {
+			result.Meta.LastRefresh = elements[0].FiscalDateEnding
+		}
+	}
+
+	return result, nil
+}
+
+func parseEarningsElements(d *[]map[string]string) ([]*EarningsElement, error) {
+
+	if d == nil {
+		return nil, errors.New("no data available to be parsed")
+	}
+
+	elements := []*EarningsElement{}
+
+	for i, m := range *d {
+		if m == nil {
+			return nil, fmt.Errorf("v is nil for element %d", i)
+		}
+
+		t, err := common.ParseDate(m["fiscalDateEnding"])
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %v", i, err)
+		}
+
+		ele := &EarningsElement{
+			FiscalDateEnding: t,
+			ReportTime:       m["reportTime"],
+		}
+
+		ele.ReportedDate, err = common.ParseOptionalDate(m["reportedDate"])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing reportedDate (%s) for %s: %v", m["reportedDate"], m["fiscalDateEnding"], err)
+		}
+
+		values := map[string]*common.OptionalFloat64{
+			"reportedEPS":        &ele.ReportedEPS,
+			"estimatedEPS":       &ele.EstimatedEPS,
+			"surprise":           &ele.Surprise,
+			"surprisePercentage": &ele.SurprisePercentage,
+		}
+
+		for k, v := range values {
+			*v, err = common.ParseOptionalFloat64(m[k])
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s (%s) for %s: %v", k, m[k], m["fiscalDateEnding"], err)
+			}
+		}
+
+		elements = append(elements, ele)
+	}
+
+	// Sort is descending ... most recent date first
+	slices.SortFunc(elements, func(a, b *EarningsElement) int {
+		return b.FiscalDateEnding.Compare(a.FiscalDateEnding)
+	})
+
+	return elements, nil
+}
@@ -0,0 +1,122 @@
+package fundamentals
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/listing"
+)
+
+// GetEarningsCalendar uses the provided apiKey to retrieve the expected earnings announcements
+func GetEarningsCalendar(apiKey string, opts ...func(*CalendarOptions) error) (*EarningsCalendar, error) {
+
+	var o = defaultCalendarOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	q := common.NewQuery("EARNINGS_CALENDAR").Set("horizon", o.Horizon.String())
+	if o.Symbol != "" {
+		q.Symbol(o.Symbol)
+	}
+
+	url, err := q.URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	return parseEarningsCalendarCsv(resp.Body, &o)
+}
+
+func parseEarningsCalendarCsv(data io.Reader, o *CalendarOptions) (*EarningsCalendar, error) {
+
+	reader := csv.NewReader(data)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error reading header: %w: %w", err, common.ErrRemoteCallError)
+	}
+
+	colIndex := map[string]int{}
+	for i, col := range header {
+		colIndex[strings.ToLower(col)] = i
+	}
+
+	for _, col := range []string{"symbol", "name", "reportdate", "fiscaldateending", "estimate", "currency"} {
+		if _, ok := colIndex[col]; !ok {
+			return nil, fmt.Errorf("missing column '%s' in header: %w", col, common.ErrParseError)
+		}
+	}
+
+	var entries = []*CalendarEntry{}
+
+	var line = 0
+	for {
+		line++
+		record, err := reader.Read()
+		if err == io.EOF {
+			break // Done
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(record) != len(header) {
+			continue
+		}
+
+		reportDate, err := common.ParseDate(record[colIndex["reportdate"]])
+		if err != nil {
+			return nil, fmt.Errorf("line: %d: reportDate error parsing '%s': %v", line, record[colIndex["reportdate"]], err)
+		}
+
+		fiscalDate, err := common.ParseDate(record[colIndex["fiscaldateending"]])
+		if err != nil {
+			return nil, fmt.Errorf("line: %d: fiscalDateEnding error parsing '%s': %v", line, record[colIndex["fiscaldateending"]], err)
+		}
+
+		estimate, err := common.ParseOptionalFloat64(record[colIndex["estimate"]])
+		if err != nil {
+			return nil, fmt.Errorf("line: %d: estimate error parsing '%s': %v", line, record[colIndex["estimate"]], err)
+		}
+
+		entries = append(entries, &CalendarEntry{
+			Symbol:           listing.Symbol(record[colIndex["symbol"]]),
+			Name:             record[colIndex["name"]],
+			ReportDate:       reportDate,
+			FiscalDateEnding: fiscalDate,
+			Estimate:         estimate,
+			Currency:         record[colIndex["currency"]],
+		})
+	}
+
+	// Sort is ascending ... next announcement first
+	slices.SortStableFunc(entries, func(a, b *CalendarEntry) int {
+		return a.ReportDate.Compare(b.ReportDate)
+	})
+
+	return &EarningsCalendar{
+		Meta: &CalendarMetadata{
+			Options: o,
+		},
+		Entries: entries,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package fundamentals
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/listing"
+)
+
+func TestParseEarningsCalendarCsv(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/earnings_calendar.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var o = defaultCalendarOptions
+
+	result, err := parseEarningsCalendarCsv(bytes.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	if len(result.Entries) != 5 {
+		t.Fatalf("expected 5 entries, got %d", len(result.Entries))
+	}
+
+	for i, v := range result.Entries {
+		if i > 0 && v.ReportDate.Before(result.Entries[i-1].ReportDate) {
+			t.Fatalf("entries are not in ascending order at %d", i)
+		}
+	}
+
+	first := result.Entries[0]
+	if first.Symbol != "AA" {
+		t.Fatalf("unexpected first entry: %v", first.Symbol)
+	}
+
+	dt, _ := common.ParseDate("2025-10-22")
+	if first.ReportDate != dt {
+		t.Fatalf("expected first report date '2025-10-22', got '%s'", first.ReportDate)
+	}
+
+	for _, v := range result.Entries {
+		if v.Symbol == "ZZZZ" && !v.Estimate.IsUndefined() {
+			t.Fatalf("expected undefined estimate for ZZZZ, got %v", v.Estimate)
+		}
+	}
+}
+
+func TestEarningsCalendarFilter(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/earnings_calendar.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var o = defaultCalendarOptions
+
+	result, err := parseEarningsCalendarCsv(bytes.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	universe := &listing.Data{
+		Tradeables: map[listing.Symbol]*listing.Info{
+			"IBM":  {Symbol: "IBM"},
+			"AAPL": {Symbol: "AAPL"},
+		},
+	}
+
+	filtered := result.Filter(universe)
+	if len(filtered.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(filtered.Entries))
+	}
+
+	for _, v := range filtered.Entries {
+		if !universe.Contains(v.Symbol) {
+			t.Fatalf("unexpected entry after filtering: %v", v.Symbol)
+		}
+	}
+}
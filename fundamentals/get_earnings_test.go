@@ -0,0 +1,72 @@
+package fundamentals
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/listing"
+)
+
+func TestParseEarningsJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_earnings.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseEarningsJSON(data)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Symbol != "IBM" {
+		t.Fatalf("expected symbol 'IBM', got '%s'", result.Meta.Symbol)
+	}
+
+	dt, _ := common.ParseDate("2025-06-30")
+	if result.Meta.LastRefresh != dt {
+		t.Fatalf("expected last refresh '2025-06-30', got '%s'", result.Meta.LastRefresh)
+	}
+
+	if len(result.AnnualEarnings) != 3 {
+		t.Fatalf("expected 3 annual earnings, got %d", len(result.AnnualEarnings))
+	}
+
+	if len(result.QuarterlyEarnings) != 5 {
+		t.Fatalf("expected 5 quarterly earnings, got %d", len(result.QuarterlyEarnings))
+	}
+
+	latest := result.QuarterlyEarnings[0]
+	if !common.EqualFloat64(2.8, latest.ReportedEPS.Value, 4) || !common.EqualFloat64(6.0606, latest.SurprisePercentage.Value, 4) {
+		t.Fatalf("unexpected latest quarterly earnings: %v", latest)
+	}
+
+	reported, _ := common.ParseDate("2025-07-23")
+	if latest.ReportedDate.Value != reported {
+		t.Fatalf("expected reported date '2025-07-23', got '%s'", latest.ReportedDate.Value)
+	}
+
+	earliest := result.QuarterlyEarnings[len(result.QuarterlyEarnings)-1]
+	if earliest.ReportedEPS.IsUndefined() || !earliest.EstimatedEPS.IsUndefined() || !earliest.Surprise.IsUndefined() {
+		t.Fatalf("unexpected earliest quarterly earnings: %v", earliest)
+	}
+
+	if !result.AnnualEarnings[0].EstimatedEPS.IsUndefined() {
+		t.Fatalf("expected annual estimate to be undefined, got %v", result.AnnualEarnings[0].EstimatedEPS)
+	}
+
+	universe := &listing.Data{
+		Tradeables: map[listing.Symbol]*listing.Info{
+			"IBM": {Symbol: "IBM"},
+		},
+	}
+
+	if len(FilterEarnings([]*Earnings{result}, universe)) != 1 {
+		t.Fatal("expected IBM earnings to be in the universe")
+	}
+
+	if len(FilterEarnings([]*Earnings{result}, &listing.Data{})) != 0 {
+		t.Fatal("expected IBM earnings to be filtered out of an empty universe")
+	}
+}
@@ -0,0 +1,152 @@
+package fundamentals
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// GetOverview uses the provided apiKey to retrieve the company overview for the symbol
+func GetOverview(symbol, apiKey string) (*Overview, error) {
+
+	url, err := common.NewQuery("OVERVIEW").Symbol(symbol).URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseOverviewJSON(b)
+}
+
+func parseOverviewJSON(b []byte) (*Overview, error) {
+	// All values in the OVERVIEW response are strings, including any error or information message
+	var d map[string]string
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if msg, ok := d["Error Message"]; ok {
+		return nil, fmt.Errorf("api error: %s: %w", msg, common.ErrRemoteCallError)
+	}
+	if msg, ok := d["Information"]; ok {
+		return nil, fmt.Errorf("api error: %s: %w", msg, common.ErrRemoteCallError)
+	}
+	if d["Symbol"] == "" {
+		// Unknown symbols return an empty object
+		return nil, fmt.Errorf("api error: expected Symbol, got nil: %w", common.ErrRemoteCallError)
+	}
+
+	result := &Overview{
+		Meta: &Metadata{
+			Symbol: d["Symbol"],
+		},
+		Name:          d["Name"],
+		Description:   d["Description"],
+		AssetType:     d["AssetType"],
+		CIK:           d["CIK"],
+		Exchange:      d["Exchange"],
+		Currency:      d["Currency"],
+		Country:       d["Country"],
+		Sector:        d["Sector"],
+		Industry:      d["Industry"],
+		Address:       d["Address"],
+		OfficialSite:  d["OfficialSite"],
+		FiscalYearEnd: d["FiscalYearEnd"],
+	}
+
+	if err := parseOverviewValues(d, result); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+
+	if !result.LatestQuarter.IsUndefined() {
+		result.Meta.LastRefresh = result.LatestQuarter.Value
+	}
+
+	return result, nil
+}
+
+func parseOverviewValues(d map[string]string, r *Overview) error {
+
+	values := map[string]*common.OptionalFloat64{
+		"MarketCapitalization":       &r.MarketCapitalization,
+		"EBITDA":                     &r.EBITDA,
+		"PERatio":                    &r.PERatio,
+		"PEGRatio":                   &r.PEGRatio,
+		"BookValue":                  &r.BookValue,
+		"DividendPerShare":           &r.DividendPerShare,
+		"DividendYield":              &r.DividendYield,
+		"EPS":                        &r.EPS,
+		"RevenuePerShareTTM":         &r.RevenuePerShareTTM,
+		"ProfitMargin":               &r.ProfitMargin,
+		"OperatingMarginTTM":         &r.OperatingMarginTTM,
+		"ReturnOnAssetsTTM":          &r.ReturnOnAssetsTTM,
+		"ReturnOnEquityTTM":          &r.ReturnOnEquityTTM,
+		"RevenueTTM":                 &r.RevenueTTM,
+		"GrossProfitTTM":             &r.GrossProfitTTM,
+		"DilutedEPSTTM":              &r.DilutedEPSTTM,
+		"QuarterlyEarningsGrowthYOY": &r.QuarterlyEarningsGrowthYOY,
+		"QuarterlyRevenueGrowthYOY":  &r.QuarterlyRevenueGrowthYOY,
+		"AnalystTargetPrice":         &r.AnalystTargetPrice,
+		"AnalystRatingStrongBuy":     &r.AnalystRatingStrongBuy,
+		"AnalystRatingBuy":           &r.AnalystRatingBuy,
+		"AnalystRatingHold":          &r.AnalystRatingHold,
+		"AnalystRatingSell":          &r.AnalystRatingSell,
+		"AnalystRatingStrongSell":    &r.AnalystRatingStrongSell,
+		"TrailingPE":                 &r.TrailingPE,
+		"ForwardPE":                  &r.ForwardPE,
+		"PriceToSalesRatioTTM":       &r.PriceToSalesRatioTTM,
+		"PriceToBookRatio":           &r.PriceToBookRatio,
+		"EVToRevenue":                &r.EVToRevenue,
+		"EVToEBITDA":                 &r.EVToEBITDA,
+		"Beta":                       &r.Beta,
+		"52WeekHigh":                 &r.FiftyTwoWeekHigh,
+		"52WeekLow":                  &r.FiftyTwoWeekLow,
+		"50DayMovingAverage":         &r.FiftyDayMovingAverage,
+		"200DayMovingAverage":        &r.TwoHundredDayMovingAverage,
+		"SharesOutstanding":          &r.SharesOutstanding,
+		"SharesFloat":                &r.SharesFloat,
+		"PercentInsiders":            &r.PercentInsiders,
+		"PercentInstitutions":        &r.PercentInstitutions,
+	}
+
+	// Keys absent from the response are left undefined
+	for k, v := range values {
+		var err error
+		*v, err = common.ParseOptionalFloat64(d[k])
+		if err != nil {
+			return fmt.Errorf("error parsing %s (%s): %v", k, d[k], err)
+		}
+	}
+
+	dates := map[string]*common.OptionalDate{
+		"LatestQuarter":  &r.LatestQuarter,
+		"DividendDate":   &r.DividendDate,
+		"ExDividendDate": &r.ExDividendDate,
+	}
+
+	for k, v := range dates {
+		var err error
+		*v, err = common.ParseOptionalDate(d[k])
+		if err != nil {
+			return fmt.Errorf("error parsing %s (%s): %v", k, d[k], err)
+		}
+	}
+
+	return nil
+}
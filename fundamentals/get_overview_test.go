@@ -0,0 +1,58 @@
+package fundamentals
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestParseOverviewJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_overview.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseOverviewJSON(data)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Symbol != "IBM" {
+		t.Fatalf("expected symbol 'IBM', got '%s'", result.Meta.Symbol)
+	}
+
+	if result.Sector != "TECHNOLOGY" {
+		t.Fatalf("expected sector 'TECHNOLOGY', got '%s'", result.Sector)
+	}
+
+	dt, _ := common.ParseDate("2025-06-30")
+	if result.Meta.LastRefresh != dt {
+		t.Fatalf("expected last refresh '2025-06-30', got '%s'", result.Meta.LastRefresh)
+	}
+
+	if result.MarketCapitalization.IsUndefined() || !common.EqualFloat64(223676039000, result.MarketCapitalization.Value, 0) {
+		t.Fatalf("unexpected market capitalization: %v", result.MarketCapitalization)
+	}
+
+	if result.FiftyTwoWeekHigh.IsUndefined() || !common.EqualFloat64(296.16, result.FiftyTwoWeekHigh.Value, 2) {
+		t.Fatalf("unexpected 52 week high: %v", result.FiftyTwoWeekHigh)
+	}
+
+	if !result.AnalystRatingStrongSell.IsUndefined() {
+		t.Fatalf("expected undefined strong sell rating, got %v", result.AnalystRatingStrongSell)
+	}
+
+	if !result.ExDividendDate.IsUndefined() {
+		t.Fatalf("expected undefined ex-dividend date, got %v", result.ExDividendDate)
+	}
+}
+
+func TestParseOverviewJSON_1(t *testing.T) {
+
+	_, err := parseOverviewJSON([]byte(`{}`))
+	if err == nil {
+		t.Fatal("expected error for empty response, got nil")
+	}
+}
@@ -0,0 +1,141 @@
+package fundamentals
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// respStatementJSON captures all possible return JSON
+type respStatementJSON struct {
+	Info      *string              `json:"Information"`
+	Err       *string              `json:"Error Message"`
+	Symbol    *string              `json:"symbol"`
+	Annual    *[]map[string]string `json:"annualReports"`
+	Quarterly *[]map[string]string `json:"quarterlyReports"`
+}
+
+// ErrInvalidStatementType returned when an invalid statement type is specified
+var ErrInvalidStatementType = errors.New("invalid statement type specified")
+
+// GetStatement uses the provided apiKey to retrieve the annual and quarterly reports of
+// the specified StatementType for the symbol
+func GetStatement(symbol string, statementType StatementType, apiKey string) (*Statement, error) {
+
+	if !statementType.isValid() {
+		return nil, ErrInvalidStatementType
+	}
+
+	url, err := common.NewQuery(statementType.toAVString()).Symbol(symbol).URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseStatementJSON(b, statementType)
+}
+
+func parseStatementJSON(b []byte, statementType StatementType) (*Statement, error) {
+	var d respStatementJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+	if d.Symbol == nil {
+		return nil, fmt.Errorf("api error: expected Symbol, got nil: %w", common.ErrRemoteCallError)
+	}
+
+	result := &Statement{
+		Meta: &Metadata{
+			Symbol: *d.Symbol,
+		},
+		Type: statementType,
+	}
+
+	var err error
+	result.AnnualReports, err = parseReports(d.Annual, statementType)
+	if err != nil {
+		return nil, fmt.Errorf("annual reports: %v: %w", err, common.ErrTimeSeriesParseError)
+	}
+
+	result.QuarterlyReports, err = parseReports(d.Quarterly, statementType)
+	if err != nil {
+		return nil, fmt.Errorf("quarterly reports: %v: %w", err, common.ErrTimeSeriesParseError)
+	}
+
+	for _, reports := range [][]*Report{result.AnnualReports, result.QuarterlyReports} {
+		if len(reports) > 0 && reports[0].FiscalDateEnding.After(result.Meta.LastRefresh) {
+			result.Meta.LastRefresh = reports[0].FiscalDateEnding
+		}
+	}
+
+	return result, nil
+}
+
+func parseReports(d *[]map[string]string, statementType StatementType) ([]*Report, error) {
+
+	if d == nil {
+		return nil, errors.New("no data available to be parsed")
+	}
+
+	reports := []*Report{}
+
+	for i, m := range *d {
+		if m == nil {
+			return nil, fmt.Errorf("v is nil for element %d", i)
+		}
+
+		t, err := common.ParseDate(m["fiscalDateEnding"])
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %v", i, err)
+		}
+
+		r := &Report{
+			FiscalDateEnding: t,
+			ReportedCurrency: m["reportedCurrency"],
+			Data:             map[LineItem]common.OptionalFloat64{},
+		}
+
+		// Every line item of the statement is recorded, undefined if missing from the response
+		for _, item := range statementType.lineItems() {
+			v, err := common.ParseOptionalFloat64(m[item.String()])
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s (%s) for %s: %v", item, m[item.String()], m["fiscalDateEnding"], err)
+			}
+			r.Data[item] = v
+		}
+
+		reports = append(reports, r)
+	}
+
+	// Sort is descending ... most recent date first
+	slices.SortFunc(reports, func(a, b *Report) int {
+		return b.FiscalDateEnding.Compare(a.FiscalDateEnding)
+	})
+
+	return reports, nil
+}
@@ -0,0 +1,162 @@
+package fundamentals
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestParseStatementJSON(t *testing.T) {
+
+	type test struct {
+		file          string
+		statementType StatementType
+		item          LineItem
+		value         float64
+		missing       LineItem
+	}
+
+	tests := []test{
+		{
+			file:          "../example_data/ibm_income_statement.json",
+			statementType: IncomeStatement,
+			item:          TotalRevenue,
+			value:         62753000000,
+			missing:       InterestIncome,
+		},
+		{
+			file:          "../example_data/ibm_balance_sheet.json",
+			statementType: BalanceSheet,
+			item:          TotalAssets,
+			value:         137175000000,
+			missing:       DeferredRevenue,
+		},
+		{
+			file:          "../example_data/ibm_cash_flow.json",
+			statementType: CashFlow,
+			item:          OperatingCashFlow,
+			value:         13445000000,
+			missing:       ProfitLoss,
+		},
+	}
+
+	for _, tt := range tests {
+		data, err := os.ReadFile(tt.file)
+		if err != nil {
+			t.Fatalf("failed to read test data: %v", err)
+		}
+
+		result, err := parseStatementJSON(data, tt.statementType)
+		if err != nil {
+			t.Fatalf("%s: failed to parse JSON: %v", tt.statementType, err)
+		}
+
+		if result.Meta.Symbol != "IBM" {
+			t.Fatalf("%s: expected symbol 'IBM', got '%s'", tt.statementType, result.Meta.Symbol)
+		}
+
+		dt, _ := common.ParseDate("2025-06-30")
+		if result.Meta.LastRefresh != dt {
+			t.Fatalf("%s: expected last refresh '2025-06-30', got '%s'", tt.statementType, result.Meta.LastRefresh)
+		}
+
+		if len(result.AnnualReports) != 2 {
+			t.Fatalf("%s: expected 2 annual reports, got %d", tt.statementType, len(result.AnnualReports))
+		}
+
+		latest := result.AnnualReports[0]
+		if latest.ReportedCurrency != "USD" {
+			t.Fatalf("%s: expected currency 'USD', got '%s'", tt.statementType, latest.ReportedCurrency)
+		}
+
+		if len(latest.Data) != len(tt.statementType.lineItems()) {
+			t.Fatalf("%s: expected %d line items, got %d", tt.statementType, len(tt.statementType.lineItems()), len(latest.Data))
+		}
+
+		v := latest.Data[tt.item]
+		if v.IsUndefined() || !common.EqualFloat64(tt.value, v.Value, 0) {
+			t.Fatalf("%s: unexpected value for %s: %v", tt.statementType, tt.item, v)
+		}
+
+		if !latest.Data[tt.missing].IsUndefined() {
+			t.Fatalf("%s: expected %s to be undefined, got %v", tt.statementType, tt.missing, latest.Data[tt.missing])
+		}
+	}
+}
+
+func TestStatementAsOf(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_income_statement.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseStatementJSON(data, IncomeStatement)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	r := result.AsOf(time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), Annual, DefaultReportingLag)
+	if r == nil {
+		t.Fatal("expected report, got nil")
+	}
+	if r.FiscalDateEnding != time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("expected report for 2023-12-31, got %v", r.FiscalDateEnding)
+	}
+
+	// The 2023 figures, the earliest available, had not been published by the start of 2024
+	if r := result.AsOf(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Annual, DefaultReportingLag); r != nil {
+		t.Fatalf("expected nil report, got %v", r.FiscalDateEnding)
+	}
+
+	r = result.AsOf(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), Quarterly, 0)
+	if r == nil || r.FiscalDateEnding != time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("expected quarterly report for 2025-06-30, got %v", r)
+	}
+}
+
+func TestStatementAsReported(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_income_statement.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseStatementJSON(data, IncomeStatement)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	data, err = os.ReadFile("../example_data/ibm_earnings.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	earnings, err := parseEarningsJSON(data)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	// Q2 2025 was reported on 2025-07-23
+	r := result.AsReported(time.Date(2025, 7, 22, 0, 0, 0, 0, time.UTC), Quarterly, earnings)
+	if r == nil || r.FiscalDateEnding != time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("expected quarterly report for 2025-03-31, got %v", r)
+	}
+
+	r = result.AsReported(time.Date(2025, 7, 23, 0, 0, 0, 0, time.UTC), Quarterly, earnings)
+	if r == nil || r.FiscalDateEnding != time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("expected quarterly report for 2025-06-30, got %v", r)
+	}
+
+	// FY2024 was reported with Q4 2024 on 2025-01-29, and the reported date of FY2023 is not known
+	r = result.AsReported(time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC), Annual, earnings)
+	if r == nil || r.FiscalDateEnding != time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("expected annual report for 2024-12-31, got %v", r)
+	}
+
+	if r := result.AsReported(time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC), Annual, earnings); r != nil {
+		t.Fatalf("expected nil report, got %v", r.FiscalDateEnding)
+	}
+}
@@ -0,0 +1,106 @@
+package fundamentals
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// respTranscriptJSON captures all possible return JSON
+type respTranscriptJSON struct {
+	Info       *string                 `json:"Information"`
+	Err        *string                 `json:"Error Message"`
+	Symbol     string                  `json:"symbol"`
+	Quarter    string                  `json:"quarter"`
+	Transcript *[]*respSpeakerTurnJSON `json:"transcript"`
+}
+
+type respSpeakerTurnJSON struct {
+	Speaker   string `json:"speaker"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	Sentiment string `json:"sentiment"`
+}
+
+// earliestTranscriptYear is the first year for which transcripts are available
+const earliestTranscriptYear = 2010
+
+// GetTranscript uses the provided apiKey to retrieve the transcript of the earnings call
+// for the symbol in the specified fiscal year and quarter (1 to 4)
+func GetTranscript(symbol string, year, quarter int, apiKey string) (*Transcript, error) {
+
+	if year < earliestTranscriptYear {
+		return nil, fmt.Errorf("invalid year specified: %d", year)
+	}
+	if quarter < 1 || quarter > 4 {
+		return nil, fmt.Errorf("invalid quarter specified: %d", quarter)
+	}
+
+	url, err := common.NewQuery("EARNINGS_CALL_TRANSCRIPT").
+		Symbol(symbol).
+		Set("quarter", fmt.Sprintf("%dQ%d", year, quarter)).
+		URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseTranscriptJSON(b)
+}
+
+func parseTranscriptJSON(b []byte) (*Transcript, error) {
+	var d respTranscriptJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+	if d.Transcript == nil {
+		return nil, fmt.Errorf("no transcript available to be parsed: %w", common.ErrParseError)
+	}
+
+	result := &Transcript{
+		Symbol:  d.Symbol,
+		Quarter: d.Quarter,
+		Turns:   []*SpeakerTurn{},
+	}
+
+	for _, t := range *d.Transcript {
+		if t == nil {
+			continue
+		}
+		s, err := common.ParseOptionalFloat64(t.Sentiment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sentiment %s for %s: %w", t.Sentiment, t.Speaker, common.ErrParseError)
+		}
+		result.Turns = append(result.Turns, &SpeakerTurn{
+			Speaker:   t.Speaker,
+			Title:     t.Title,
+			Content:   t.Content,
+			Sentiment: s,
+		})
+	}
+
+	return result, nil
+}
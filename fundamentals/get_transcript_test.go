@@ -0,0 +1,54 @@
+package fundamentals
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestParseTranscriptJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_transcript.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseTranscriptJSON(data)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Symbol != "IBM" || result.Quarter != "2024Q1" {
+		t.Fatalf("unexpected transcript: %s %s", result.Symbol, result.Quarter)
+	}
+
+	if len(result.Turns) != 3 {
+		t.Fatalf("expected 3 speaker turns, got %d", len(result.Turns))
+	}
+
+	turn := result.Turns[1]
+	if turn.Speaker != "Arvind Krishna" || turn.Title != "Chairman and Chief Executive Officer" {
+		t.Fatalf("unexpected speaker: %s (%s)", turn.Speaker, turn.Title)
+	}
+
+	if !common.EqualFloat64(0.7, turn.Sentiment.Value, 1) {
+		t.Fatalf("unexpected sentiment: %v", turn.Sentiment)
+	}
+
+	if !result.Turns[2].Sentiment.IsUndefined() {
+		t.Fatalf("expected undefined sentiment, got %v", result.Turns[2].Sentiment)
+	}
+}
+
+func TestGetTranscript(t *testing.T) {
+
+	// Invalid requests are rejected before any remote call is made
+	if _, err := GetTranscript("IBM", 2024, 5, "KEY"); err == nil {
+		t.Fatal("expected error for invalid quarter, got nil")
+	}
+
+	if _, err := GetTranscript("IBM", 2009, 1, "KEY"); err == nil {
+		t.Fatal("expected error for invalid year, got nil")
+	}
+}
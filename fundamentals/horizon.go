@@ -0,0 +1,31 @@
+package fundamentals
+
+type Horizon int
+
+const (
+	UnknownHorizon Horizon = iota
+	ThreeMonths
+	SixMonths
+	TwelveMonths
+	InvalidHorizon
+)
+
+func (h Horizon) String() string {
+	switch h {
+	case ThreeMonths:
+		return "3month"
+	case SixMonths:
+		return "6month"
+	case TwelveMonths:
+		return "12month"
+	default:
+		panic("invalid value of Horizon")
+	}
+}
+
+func (h Horizon) isValid() bool {
+	if h <= UnknownHorizon || h >= InvalidHorizon {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,384 @@
+package fundamentals
+
+// LineItem identifies a single value reported in a financial statement
+type LineItem int
+
+const (
+	UnknownLineItem LineItem = iota
+	// Income statement
+	GrossProfit
+	TotalRevenue
+	CostOfRevenue
+	CostOfGoodsAndServicesSold
+	OperatingIncome
+	SellingGeneralAndAdministrative
+	ResearchAndDevelopment
+	OperatingExpenses
+	InvestmentIncomeNet
+	NetInterestIncome
+	InterestIncome
+	InterestExpense
+	NonInterestIncome
+	OtherNonOperatingIncome
+	Depreciation
+	DepreciationAndAmortization
+	IncomeBeforeTax
+	IncomeTaxExpense
+	InterestAndDebtExpense
+	NetIncomeFromContinuingOperations
+	ComprehensiveIncomeNetOfTax
+	EBIT
+	EBITDA
+	NetIncome
+	// Balance sheet
+	TotalAssets
+	TotalCurrentAssets
+	CashAndCashEquivalentsAtCarryingValue
+	CashAndShortTermInvestments
+	Inventory
+	CurrentNetReceivables
+	TotalNonCurrentAssets
+	PropertyPlantEquipment
+	AccumulatedDepreciationAmortizationPPE
+	IntangibleAssets
+	IntangibleAssetsExcludingGoodwill
+	Goodwill
+	Investments
+	LongTermInvestments
+	ShortTermInvestments
+	OtherCurrentAssets
+	OtherNonCurrentAssets
+	TotalLiabilities
+	TotalCurrentLiabilities
+	CurrentAccountsPayable
+	DeferredRevenue
+	CurrentDebt
+	ShortTermDebt
+	TotalNonCurrentLiabilities
+	CapitalLeaseObligations
+	LongTermDebt
+	CurrentLongTermDebt
+	LongTermDebtNoncurrent
+	ShortLongTermDebtTotal
+	OtherCurrentLiabilities
+	OtherNonCurrentLiabilities
+	TotalShareholderEquity
+	TreasuryStock
+	RetainedEarnings
+	CommonStock
+	CommonStockSharesOutstanding
+	// Cash flow (NetIncome is shared with the income statement)
+	OperatingCashFlow
+	PaymentsForOperatingActivities
+	ProceedsFromOperatingActivities
+	ChangeInOperatingLiabilities
+	ChangeInOperatingAssets
+	DepreciationDepletionAndAmortization
+	CapitalExpenditures
+	ChangeInReceivables
+	ChangeInInventory
+	ProfitLoss
+	CashFlowFromInvestment
+	CashFlowFromFinancing
+	ProceedsFromRepaymentsOfShortTermDebt
+	PaymentsForRepurchaseOfCommonStock
+	PaymentsForRepurchaseOfEquity
+	PaymentsForRepurchaseOfPreferredStock
+	DividendPayout
+	DividendPayoutCommonStock
+	DividendPayoutPreferredStock
+	ProceedsFromIssuanceOfCommonStock
+	ProceedsFromIssuanceOfLongTermDebtAndCapitalSecuritiesNet
+	ProceedsFromIssuanceOfPreferredStock
+	ProceedsFromRepurchaseOfEquity
+	ProceedsFromSaleOfTreasuryStock
+	ChangeInCashAndCashEquivalents
+	ChangeInExchangeRate
+	InvalidLineItem
+)
+
+// String returns the name of the line item, as used by Alpha Vantage
+func (l LineItem) String() string {
+	switch l {
+	case GrossProfit:
+		return "grossProfit"
+	case TotalRevenue:
+		return "totalRevenue"
+	case CostOfRevenue:
+		return "costOfRevenue"
+	case CostOfGoodsAndServicesSold:
+		return "costofGoodsAndServicesSold"
+	case OperatingIncome:
+		return "operatingIncome"
+	case SellingGeneralAndAdministrative:
+		return "sellingGeneralAndAdministrative"
+	case ResearchAndDevelopment:
+		return "researchAndDevelopment"
+	case OperatingExpenses:
+		return "operatingExpenses"
+	case InvestmentIncomeNet:
+		return "investmentIncomeNet"
+	case NetInterestIncome:
+		return "netInterestIncome"
+	case InterestIncome:
+		return "interestIncome"
+	case InterestExpense:
+		return "interestExpense"
+	case NonInterestIncome:
+		return "nonInterestIncome"
+	case OtherNonOperatingIncome:
+		return "otherNonOperatingIncome"
+	case Depreciation:
+		return "depreciation"
+	case DepreciationAndAmortization:
+		return "depreciationAndAmortization"
+	case IncomeBeforeTax:
+		return "incomeBeforeTax"
+	case IncomeTaxExpense:
+		return "incomeTaxExpense"
+	case InterestAndDebtExpense:
+		return "interestAndDebtExpense"
+	case NetIncomeFromContinuingOperations:
+		return "netIncomeFromContinuingOperations"
+	case ComprehensiveIncomeNetOfTax:
+		return "comprehensiveIncomeNetOfTax"
+	case EBIT:
+		return "ebit"
+	case EBITDA:
+		return "ebitda"
+	case NetIncome:
+		return "netIncome"
+	case TotalAssets:
+		return "totalAssets"
+	case TotalCurrentAssets:
+		return "totalCurrentAssets"
+	case CashAndCashEquivalentsAtCarryingValue:
+		return "cashAndCashEquivalentsAtCarryingValue"
+	case CashAndShortTermInvestments:
+		return "cashAndShortTermInvestments"
+	case Inventory:
+		return "inventory"
+	case CurrentNetReceivables:
+		return "currentNetReceivables"
+	case TotalNonCurrentAssets:
+		return "totalNonCurrentAssets"
+	case PropertyPlantEquipment:
+		return "propertyPlantEquipment"
+	case AccumulatedDepreciationAmortizationPPE:
+		return "accumulatedDepreciationAmortizationPPE"
+	case IntangibleAssets:
+		return "intangibleAssets"
+	case IntangibleAssetsExcludingGoodwill:
+		return "intangibleAssetsExcludingGoodwill"
+	case Goodwill:
+		return "goodwill"
+	case Investments:
+		return "investments"
+	case LongTermInvestments:
+		return "longTermInvestments"
+	case ShortTermInvestments:
+		return "shortTermInvestments"
+	case OtherCurrentAssets:
+		return "otherCurrentAssets"
+	case OtherNonCurrentAssets:
+		return "otherNonCurrentAssets"
+	case TotalLiabilities:
+		return "totalLiabilities"
+	case TotalCurrentLiabilities:
+		return "totalCurrentLiabilities"
+	case CurrentAccountsPayable:
+		return "currentAccountsPayable"
+	case DeferredRevenue:
+		return "deferredRevenue"
+	case CurrentDebt:
+		return "currentDebt"
+	case ShortTermDebt:
+		return "shortTermDebt"
+	case TotalNonCurrentLiabilities:
+		return "totalNonCurrentLiabilities"
+	case CapitalLeaseObligations:
+		return "capitalLeaseObligations"
+	case LongTermDebt:
+		return "longTermDebt"
+	case CurrentLongTermDebt:
+		return "currentLongTermDebt"
+	case LongTermDebtNoncurrent:
+		return "longTermDebtNoncurrent"
+	case ShortLongTermDebtTotal:
+		return "shortLongTermDebtTotal"
+	case OtherCurrentLiabilities:
+		return "otherCurrentLiabilities"
+	case OtherNonCurrentLiabilities:
+		return "otherNonCurrentLiabilities"
+	case TotalShareholderEquity:
+		return "totalShareholderEquity"
+	case TreasuryStock:
+		return "treasuryStock"
+	case RetainedEarnings:
+		return "retainedEarnings"
+	case CommonStock:
+		return "commonStock"
+	case CommonStockSharesOutstanding:
+		return "commonStockSharesOutstanding"
+	case OperatingCashFlow:
+		return "operatingCashflow"
+	case PaymentsForOperatingActivities:
+		return "paymentsForOperatingActivities"
+	case ProceedsFromOperatingActivities:
+		return "proceedsFromOperatingActivities"
+	case ChangeInOperatingLiabilities:
+		return "changeInOperatingLiabilities"
+	case ChangeInOperatingAssets:
+		return "changeInOperatingAssets"
+	case DepreciationDepletionAndAmortization:
+		return "depreciationDepletionAndAmortization"
+	case CapitalExpenditures:
+		return "capitalExpenditures"
+	case ChangeInReceivables:
+		return "changeInReceivables"
+	case ChangeInInventory:
+		return "changeInInventory"
+	case ProfitLoss:
+		return "profitLoss"
+	case CashFlowFromInvestment:
+		return "cashflowFromInvestment"
+	case CashFlowFromFinancing:
+		return "cashflowFromFinancing"
+	case ProceedsFromRepaymentsOfShortTermDebt:
+		return "proceedsFromRepaymentsOfShortTermDebt"
+	case PaymentsForRepurchaseOfCommonStock:
+		return "paymentsForRepurchaseOfCommonStock"
+	case PaymentsForRepurchaseOfEquity:
+		return "paymentsForRepurchaseOfEquity"
+	case PaymentsForRepurchaseOfPreferredStock:
+		return "paymentsForRepurchaseOfPreferredStock"
+	case DividendPayout:
+		return "dividendPayout"
+	case DividendPayoutCommonStock:
+		return "dividendPayoutCommonStock"
+	case DividendPayoutPreferredStock:
+		return "dividendPayoutPreferredStock"
+	case ProceedsFromIssuanceOfCommonStock:
+		return "proceedsFromIssuanceOfCommonStock"
+	case ProceedsFromIssuanceOfLongTermDebtAndCapitalSecuritiesNet:
+		return "proceedsFromIssuanceOfLongTermDebtAndCapitalSecuritiesNet"
+	case ProceedsFromIssuanceOfPreferredStock:
+		return "proceedsFromIssuanceOfPreferredStock"
+	case ProceedsFromRepurchaseOfEquity:
+		return "proceedsFromRepurchaseOfEquity"
+	case ProceedsFromSaleOfTreasuryStock:
+		return "proceedsFromSaleOfTreasuryStock"
+	case ChangeInCashAndCashEquivalents:
+		return "changeInCashAndCashEquivalents"
+	case ChangeInExchangeRate:
+		return "changeInExchangeRate"
+	default:
+		panic("invalid value of LineItem")
+	}
+}
+
+func (l LineItem) isValid() bool {
+	if l <= UnknownLineItem || l >= InvalidLineItem {
+		return false
+	}
+	return true
+}
+
+// incomeStatementItems are the line items reported in INCOME_STATEMENT
+var incomeStatementItems = []LineItem{
+	GrossProfit,
+	TotalRevenue,
+	CostOfRevenue,
+	CostOfGoodsAndServicesSold,
+	OperatingIncome,
+	SellingGeneralAndAdministrative,
+	ResearchAndDevelopment,
+	OperatingExpenses,
+	InvestmentIncomeNet,
+	NetInterestIncome,
+	InterestIncome,
+	InterestExpense,
+	NonInterestIncome,
+	OtherNonOperatingIncome,
+	Depreciation,
+	DepreciationAndAmortization,
+	IncomeBeforeTax,
+	IncomeTaxExpense,
+	InterestAndDebtExpense,
+	NetIncomeFromContinuingOperations,
+	ComprehensiveIncomeNetOfTax,
+	EBIT,
+	EBITDA,
+	NetIncome,
+}
+
+// balanceSheetItems are the line items reported in BALANCE_SHEET
+var balanceSheetItems = []LineItem{
+	TotalAssets,
+	TotalCurrentAssets,
+	CashAndCashEquivalentsAtCarryingValue,
+	CashAndShortTermInvestments,
+	Inventory,
+	CurrentNetReceivables,
+	TotalNonCurrentAssets,
+	PropertyPlantEquipment,
+	AccumulatedDepreciationAmortizationPPE,
+	IntangibleAssets,
+	IntangibleAssetsExcludingGoodwill,
+	Goodwill,
+	Investments,
+	LongTermInvestments,
+	ShortTermInvestments,
+	OtherCurrentAssets,
+	OtherNonCurrentAssets,
+	TotalLiabilities,
+	TotalCurrentLiabilities,
+	CurrentAccountsPayable,
+	DeferredRevenue,
+	CurrentDebt,
+	ShortTermDebt,
+	TotalNonCurrentLiabilities,
+	CapitalLeaseObligations,
+	LongTermDebt,
+	CurrentLongTermDebt,
+	LongTermDebtNoncurrent,
+	ShortLongTermDebtTotal,
+	OtherCurrentLiabilities,
+	OtherNonCurrentLiabilities,
+	TotalShareholderEquity,
+	TreasuryStock,
+	RetainedEarnings,
+	CommonStock,
+	CommonStockSharesOutstanding,
+}
+
+// cashFlowItems are the line items reported in CASH_FLOW
+var cashFlowItems = []LineItem{
+	OperatingCashFlow,
+	PaymentsForOperatingActivities,
+	ProceedsFromOperatingActivities,
+	ChangeInOperatingLiabilities,
+	ChangeInOperatingAssets,
+	DepreciationDepletionAndAmortization,
+	CapitalExpenditures,
+	ChangeInReceivables,
+	ChangeInInventory,
+	ProfitLoss,
+	CashFlowFromInvestment,
+	CashFlowFromFinancing,
+	ProceedsFromRepaymentsOfShortTermDebt,
+	PaymentsForRepurchaseOfCommonStock,
+	PaymentsForRepurchaseOfEquity,
+	PaymentsForRepurchaseOfPreferredStock,
+	DividendPayout,
+	DividendPayoutCommonStock,
+	DividendPayoutPreferredStock,
+	ProceedsFromIssuanceOfCommonStock,
+	ProceedsFromIssuanceOfLongTermDebtAndCapitalSecuritiesNet,
+	ProceedsFromIssuanceOfPreferredStock,
+	ProceedsFromRepurchaseOfEquity,
+	ProceedsFromSaleOfTreasuryStock,
+	ChangeInCashAndCashEquivalents,
+	ChangeInExchangeRate,
+	NetIncome,
+}
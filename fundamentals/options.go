@@ -0,0 +1,37 @@
+package fundamentals
+
+import (
+	"errors"
+	"strings"
+)
+
+// CalendarOptions can change the returned EarningsCalendar from GetEarningsCalendar
+type CalendarOptions struct {
+	// Horizon is how far ahead the calendar extends.  Default: 3 months
+	Horizon Horizon
+	// Symbol, if set, restricts the calendar to the specified symbol.  Default: all symbols
+	Symbol string
+}
+
+// WithHorizon sets how far ahead the calendar extends
+func WithHorizon(horizon Horizon) func(*CalendarOptions) error {
+	return func(o *CalendarOptions) error {
+		if !horizon.isValid() {
+			return errors.New("invalid horizon")
+		}
+		o.Horizon = horizon
+		return nil
+	}
+}
+
+// WithCalendarSymbol restricts the calendar to the specified symbol
+func WithCalendarSymbol(symbol string) func(*CalendarOptions) error {
+	return func(o *CalendarOptions) error {
+		o.Symbol = strings.ToUpper(symbol)
+		return nil
+	}
+}
+
+var defaultCalendarOptions = CalendarOptions{
+	Horizon: ThreeMonths,
+}
@@ -0,0 +1,28 @@
+package fundamentals
+
+type Period int
+
+const (
+	UnknownPeriod Period = iota
+	Annual
+	Quarterly
+	InvalidPeriod
+)
+
+func (p Period) String() string {
+	switch p {
+	case Annual:
+		return "annual"
+	case Quarterly:
+		return "quarterly"
+	default:
+		panic("invalid value of Period")
+	}
+}
+
+func (p Period) isValid() bool {
+	if p <= UnknownPeriod || p >= InvalidPeriod {
+		return false
+	}
+	return true
+}
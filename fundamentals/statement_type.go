@@ -0,0 +1,58 @@
+package fundamentals
+
+type StatementType int
+
+const (
+	UnknownStatementType StatementType = iota
+	IncomeStatement
+	BalanceSheet
+	CashFlow
+	InvalidStatementType
+)
+
+func (s StatementType) String() string {
+	switch s {
+	case IncomeStatement:
+		return "income statement"
+	case BalanceSheet:
+		return "balance sheet"
+	case CashFlow:
+		return "cash flow"
+	default:
+		panic("invalid value of StatementType")
+	}
+}
+
+func (s StatementType) toAVString() string {
+	switch s {
+	case IncomeStatement:
+		return "INCOME_STATEMENT"
+	case BalanceSheet:
+		return "BALANCE_SHEET"
+	case CashFlow:
+		return "CASH_FLOW"
+	default:
+		panic("invalid value of StatementType")
+	}
+}
+
+// lineItems returns the LineItems reported in the statement
+func (s StatementType) lineItems() []LineItem {
+	switch s {
+	case IncomeStatement:
+		return incomeStatementItems
+	case BalanceSheet:
+		return balanceSheetItems
+	case CashFlow:
+		return cashFlowItems
+	default:
+		panic("invalid value of StatementType")
+	}
+}
+
+func (s StatementType) isValid() bool {
+	if s <= UnknownStatementType || s >= InvalidStatementType {
+		return false
+	}
+	return true
+}
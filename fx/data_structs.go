@@ -1,6 +1,11 @@
 package fx
 
-import "time"
+import (
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
 
 // Metadata describes what information was returned
 type Metadata struct {
@@ -12,6 +17,10 @@ type Metadata struct {
 	ToCurrency string
 	// LastRefresh is the time the data itself was last updated
 	LastRefresh time.Time
+	// Frequency is the spacing of the elements in the time series
+	Frequency common.Frequency
+	// Interval is the spacing of the elements when Frequency is Intraday
+	Interval intraday.Interval
 	// TimeZone is the time zone of any returned datetime values
 	TimeZone string
 	// DataRange describes the range of data that was returned
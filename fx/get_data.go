@@ -8,7 +8,6 @@ import (
 	"net/http"
 	"slices"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/gford1000-go/alphav/common"
@@ -46,7 +45,14 @@ func GetData(fromCurrency, toCurrency, apiKey string, opts ...func(*Options) err
 		outputsize = "full"
 	}
 
-	url := fmt.Sprintf("https://www.alphavantage.co/query?function=FX_DAILY&from_symbol=%s&to_symbol=%s&outputsize=%s&apikey=%s", strings.ToUpper(fromCurrency), strings.ToUpper(toCurrency), outputsize, apiKey)
+	url, err := common.NewQuery("FX_DAILY").
+		Currency("from_symbol", fromCurrency).
+		Currency("to_symbol", toCurrency).
+		Set("outputsize", outputsize).
+		URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := http.Get(url)
 	if err != nil {
@@ -87,7 +93,7 @@ func parseJSON(b []byte, o *Options) (*Data, error) {
 		return nil, fmt.Errorf("%v: %w", err, common.ErrMetadataParseError)
 	}
 
-	if err := parseTimeSeries(d.TSD, result, o); err != nil {
+	if err := parseTimeSeries(d.TSD, result, o, common.ParseDate); err != nil {
 		return nil, fmt.Errorf("%v: %w", err, common.ErrTimeSeriesParseError)
 	}
 
@@ -99,6 +105,7 @@ func parseMetadata(m *metaJSON, r *Data, o *Options) error {
 		Information:  append([]InformationType{}, o.Information...),
 		FromCurrency: m.From,
 		ToCurrency:   m.To,
+		Frequency:    common.Daily,
 		TimeZone:     m.TZ,
 	}
 
@@ -115,7 +122,8 @@ func parseMetadata(m *metaJSON, r *Data, o *Options) error {
 var earliestDate = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
 var latestDate = time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)
 
-func parseTimeSeries(i any, r *Data, o *Options) error {
+// parseTimeSeries uses parseDate to convert the keys of the time series, since their format depends upon the Frequency
+func parseTimeSeries(i any, r *Data, o *Options, parseDate func(string) (time.Time, error)) error {
 
 	if i == nil {
 		return errors.New("no data available to be parsed")
@@ -142,7 +150,7 @@ func parseTimeSeries(i any, r *Data, o *Options) error {
 			Data: map[InformationType]float64{},
 		}
 
-		t, err := common.ParseDate(k)
+		t, err := parseDate(k)
 		if err != nil {
 			return err
 		}
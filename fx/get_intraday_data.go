@@ -7,7 +7,6 @@ import (
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/gford1000-go/alphav/common"
 )
@@ -33,7 +32,13 @@ type intradayJSON struct {
 // GetIntraday uses the provided apiKey to retrieve details for the symbol
 func GetIntraday(fromCurrency, toCurrency, apiKey string) (*IntradayData, error) {
 
-	url := fmt.Sprintf("https://www.alphavantage.co/query?function=CURRENCY_EXCHANGE_RATE&from_symbol=%s&to_symbol=%s&apikey=%s", strings.ToUpper(fromCurrency), strings.ToUpper(toCurrency), apiKey)
+	url, err := common.NewQuery("CURRENCY_EXCHANGE_RATE").
+		Currency("from_currency", fromCurrency).
+		Currency("to_currency", toCurrency).
+		URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := http.Get(url)
 	if err != nil {
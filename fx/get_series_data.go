@@ -0,0 +1,177 @@
+package fx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+// respSeriesJSON captures all possible return JSON for FX_INTRADAY, FX_WEEKLY and FX_MONTHLY.
+// The numbering of the metadata keys differs between these functions, so the metadata is
+// captured as a map and values are located by the key suffix.
+type respSeriesJSON struct {
+	Info    *string           `json:"Information"`
+	Err     *string           `json:"Error Message"`
+	Meta    map[string]string `json:"Meta Data"`
+	TS1     any               `json:"Time Series FX (1min)"`
+	TS5     any               `json:"Time Series FX (5min)"`
+	TS15    any               `json:"Time Series FX (15min)"`
+	TS30    any               `json:"Time Series FX (30min)"`
+	TS60    any               `json:"Time Series FX (60min)"`
+	Weekly  any               `json:"Time Series FX (Weekly)"`
+	Monthly any               `json:"Time Series FX (Monthly)"`
+}
+
+// GetIntradaySeries uses the provided apiKey to retrieve intraday bars for the currency pair,
+// at the interval specified in the options (default: 5min)
+func GetIntradaySeries(fromCurrency, toCurrency, apiKey string, opts ...func(*Options) error) (*Data, error) {
+	return getSeries(fromCurrency, toCurrency, apiKey, common.Intraday, opts...)
+}
+
+// GetWeekly uses the provided apiKey to retrieve the weekly time series for the currency pair.
+// The full history is always returned.
+func GetWeekly(fromCurrency, toCurrency, apiKey string, opts ...func(*Options) error) (*Data, error) {
+	return getSeries(fromCurrency, toCurrency, apiKey, common.Weekly, opts...)
+}
+
+// GetMonthly uses the provided apiKey to retrieve the monthly time series for the currency pair.
+// The full history is always returned.
+func GetMonthly(fromCurrency, toCurrency, apiKey string, opts ...func(*Options) error) (*Data, error) {
+	return getSeries(fromCurrency, toCurrency, apiKey, common.Monthly, opts...)
+}
+
+func getSeries(fromCurrency, toCurrency, apiKey string, frequency common.Frequency, opts ...func(*Options) error) (*Data, error) {
+
+	o := defaultOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	var q *common.Query
+	switch frequency {
+	case common.Intraday:
+		outputsize := "compact"
+		if o.AllAvailableHistory {
+			outputsize = "full"
+		}
+		q = common.NewQuery("FX_INTRADAY").
+			Set("interval", o.Interval.String()).
+			Set("outputsize", outputsize)
+	case common.Weekly:
+		q = common.NewQuery("FX_WEEKLY")
+	case common.Monthly:
+		q = common.NewQuery("FX_MONTHLY")
+	default:
+		return nil, common.ErrInvalidInterval
+	}
+
+	url, err := q.Currency("from_symbol", fromCurrency).
+		Currency("to_symbol", toCurrency).
+		URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseSeriesJSON(b, &o, frequency)
+}
+
+func parseSeriesJSON(b []byte, o *Options, frequency common.Frequency) (*Data, error) {
+	var d respSeriesJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+
+	result := &Data{
+		Meta:       &Metadata{},
+		TimeSeries: []*Element{},
+	}
+
+	if err := parseSeriesMetadata(d.Meta, result, o, frequency); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrMetadataParseError)
+	}
+
+	var ts any
+	switch frequency {
+	case common.Intraday:
+		switch result.Meta.Interval {
+		case intraday.OneMin:
+			ts = d.TS1
+		case intraday.FiveMin:
+			ts = d.TS5
+		case intraday.FifteenMin:
+			ts = d.TS15
+		case intraday.ThirtyMin:
+			ts = d.TS30
+		case intraday.SixtyMin:
+			ts = d.TS60
+		}
+	case common.Weekly:
+		ts = d.Weekly
+	case common.Monthly:
+		ts = d.Monthly
+	}
+
+	if err := parseTimeSeries(ts, result, o, common.ParseDateOrIntradayDate); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrTimeSeriesParseError)
+	}
+
+	return result, nil
+}
+
+func parseSeriesMetadata(m map[string]string, r *Data, o *Options, frequency common.Frequency) error {
+	if m == nil {
+		return errors.New("no metadata available to be parsed")
+	}
+
+	im := &Metadata{
+		Information:  append([]InformationType{}, o.Information...),
+		FromCurrency: common.MetaValue(m, "From Symbol"),
+		ToCurrency:   common.MetaValue(m, "To Symbol"),
+		Frequency:    frequency,
+		TimeZone:     common.MetaValue(m, "Time Zone"),
+	}
+
+	var err error
+	if frequency == common.Intraday {
+		im.Interval, err = intraday.ParseInterval(common.MetaValue(m, "Interval"))
+		if err != nil {
+			return err
+		}
+	}
+
+	im.LastRefresh, err = common.ParseDateOrIntradayDate(common.MetaValue(m, "Last Refreshed"))
+	if err != nil {
+		return err
+	}
+
+	r.Meta = im
+	return nil
+}
@@ -0,0 +1,108 @@
+package fx
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+func TestParseSeriesJSON(t *testing.T) {
+
+	type test struct {
+		file      string
+		frequency common.Frequency
+		interval  intraday.Interval
+		length    int
+		first     time.Time
+		start     time.Time
+	}
+
+	tests := []test{
+		{
+			file:      "../example_data/eur_usd_intraday.json",
+			frequency: common.Intraday,
+			interval:  intraday.FiveMin,
+			length:    5,
+			first:     time.Date(2025, 8, 26, 21, 55, 0, 0, time.UTC),
+			start:     time.Date(2025, 8, 26, 21, 35, 0, 0, time.UTC),
+		},
+		{
+			file:      "../example_data/eur_usd_weekly.json",
+			frequency: common.Weekly,
+			length:    5,
+			first:     time.Date(2025, 8, 26, 0, 0, 0, 0, time.UTC),
+			start:     time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			file:      "../example_data/eur_usd_monthly.json",
+			frequency: common.Monthly,
+			length:    4,
+			first:     time.Date(2025, 8, 26, 0, 0, 0, 0, time.UTC),
+			start:     time.Date(2025, 5, 30, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		data, err := os.ReadFile(tt.file)
+		if err != nil {
+			t.Fatalf("failed to read test data: %v", err)
+		}
+
+		o := defaultOptions
+
+		result, err := parseSeriesJSON(data, &o, tt.frequency)
+		if err != nil {
+			t.Fatalf("%s: failed to parse JSON: %v", tt.frequency, err)
+		}
+
+		if result.Meta.FromCurrency != "EUR" || result.Meta.ToCurrency != "USD" {
+			t.Fatalf("%s: unexpected currency pair %s/%s", tt.frequency, result.Meta.FromCurrency, result.Meta.ToCurrency)
+		}
+
+		if result.Meta.Frequency != tt.frequency {
+			t.Fatalf("%s: unexpected frequency %v", tt.frequency, result.Meta.Frequency)
+		}
+
+		if result.Meta.Interval != tt.interval {
+			t.Fatalf("%s: unexpected interval %v", tt.frequency, result.Meta.Interval)
+		}
+
+		if result.Meta.TimeZone != "UTC" {
+			t.Fatalf("%s: expected timezone 'UTC', got '%s'", tt.frequency, result.Meta.TimeZone)
+		}
+
+		if len(result.TimeSeries) != tt.length {
+			t.Fatalf("%s: expected %d elements, got %d", tt.frequency, tt.length, len(result.TimeSeries))
+		}
+
+		if result.TimeSeries[0].Date != tt.first {
+			t.Fatalf("%s: expected first element at %v, got %v", tt.frequency, tt.first, result.TimeSeries[0].Date)
+		}
+
+		if result.Meta.DataRange.Start != tt.start {
+			t.Fatalf("%s: expected data range start %v, got %v", tt.frequency, tt.start, result.Meta.DataRange.Start)
+		}
+
+		if len(result.TimeSeries[0].Data) != len(o.Information) {
+			t.Fatalf("%s: expected %d values, got %d", tt.frequency, len(o.Information), len(result.TimeSeries[0].Data))
+		}
+	}
+}
+
+func TestParseSeriesJSON_1(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/eur_usd_intraday.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	o := defaultOptions
+
+	// Requesting the weekly series from an intraday response finds no data
+	if _, err := parseSeriesJSON(data, &o, common.Weekly); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -1,6 +1,9 @@
 package fx
 
-import "github.com/gford1000-go/alphav/common"
+import (
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
 
 // Options can change the returned Data from GetData
 type Options struct {
@@ -8,6 +11,19 @@ type Options struct {
 	Information []InformationType
 	// AllAvailableHistory = true returns all data; false is 100 records.  Default: false
 	AllAvailableHistory bool
+	// Interval specifies the interval between elements for intraday time series.  Default: 5min
+	Interval intraday.Interval
+}
+
+// WithInterval sets the interval between elements for intraday time series
+func WithInterval(interval intraday.Interval) func(*Options) error {
+	return func(o *Options) error {
+		if !interval.IsValid() {
+			return common.ErrInvalidInterval
+		}
+		o.Interval = interval
+		return nil
+	}
 }
 
 func WithAllAvailableHistory(all bool) func(*Options) error {
@@ -39,4 +55,5 @@ var defaultOptions = Options{
 		Close,
 	},
 	AllAvailableHistory: false,
+	Interval:            intraday.FiveMin,
 }
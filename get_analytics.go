@@ -0,0 +1,51 @@
+package alphav
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gford1000-go/alphav/analytics"
+	"github.com/gford1000-go/alphav/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetFixedWindowAnalytics returns the calculations over the returns of the symbols, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/#analytics-fixed-window
+func GetFixedWindowAnalytics(ctx context.Context, symbols []string, calculations []analytics.CalculationSpec, opts ...func(*analytics.Options) error) (*analytics.FixedWindowData, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetFixedWindowAnalytics")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbols", strings.Join(symbols, ",")))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return analytics.GetFixedWindow(symbols, calculations, apiKey, opts...)
+
+}
+
+// GetSlidingWindowAnalytics returns the calculations over each window of returns of the symbols, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/#analytics-sliding-window
+func GetSlidingWindowAnalytics(ctx context.Context, symbols []string, windowSize int, calculations []analytics.CalculationSpec, opts ...func(*analytics.Options) error) (*analytics.SlidingWindowData, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetSlidingWindowAnalytics")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbols", strings.Join(symbols, ",")), attribute.Int("WindowSize", windowSize))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return analytics.GetSlidingWindow(symbols, windowSize, calculations, apiKey, opts...)
+
+}
@@ -0,0 +1,113 @@
+package alphav
+
+import (
+	"context"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/historic"
+	"github.com/gford1000-go/alphav/intraday"
+	"github.com/gford1000-go/alphav/listing"
+	"github.com/gford1000-go/alphav/quote"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// BatchResult holds the outcome of a request made for each of a set of symbols, such as the members of a universe.
+// Each symbol appears in exactly one of Data or Errors.
+type BatchResult[T any] struct {
+	// Data holds the successful results, by symbol
+	Data map[listing.Symbol]T
+	// Errors holds the errors returned, by symbol
+	Errors map[listing.Symbol]error
+}
+
+type batchIntervalKey string
+
+var batchIntervalKeyName batchIntervalKey = "theBatchInterval"
+
+// WithBatchInterval sets the pause between the requests made by the GetBatch functions, so that a batch
+// can stay within the rate limit of the api_key, e.g. 12 seconds for a limit of 5 requests per minute.
+// By default requests are made one after another without any pause.
+func WithBatchInterval(ctx context.Context, interval time.Duration) context.Context {
+	return context.WithValue(ctx, batchIntervalKeyName, interval)
+}
+
+// getBatchInterval retrieves the pause between batch requests from the context, which is zero if not set
+func getBatchInterval(ctx context.Context) time.Duration {
+	if d, ok := ctx.Value(batchIntervalKeyName).(time.Duration); ok && d > 0 {
+		return d
+	}
+	return 0
+}
+
+// getBatch calls get for each symbol in turn, pausing between calls for the interval set by WithBatchInterval.
+// Rate limited requests are not retried, so are recorded in Errors.
+// If the context ends, the remaining symbols are recorded with ErrContextEnded.
+func getBatch[T any](ctx context.Context, name string, symbols []listing.Symbol, get func(context.Context, string) (T, error)) *BatchResult[T] {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, name)
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("Symbols", len(symbols)))
+
+	result := &BatchResult[T]{
+		Data:   map[listing.Symbol]T{},
+		Errors: map[listing.Symbol]error{},
+	}
+
+	interval := getBatchInterval(ctx)
+
+	for i, symbol := range symbols {
+		if i > 0 && interval > 0 && ctx.Err() == nil {
+			timer := time.NewTimer(interval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+			case <-timer.C:
+			}
+		}
+
+		if ctx.Err() != nil {
+			result.Errors[symbol] = common.ErrContextEnded
+			continue
+		}
+
+		data, err := get(ctx, string(symbol))
+		if err != nil {
+			result.Errors[symbol] = err
+			continue
+		}
+		result.Data[symbol] = data
+	}
+
+	return result
+}
+
+// GetBatchHistoricData returns historic data for each of the symbols, using the api_key stored in the context.
+// The symbols are typically the members of a universe.Universe on a date.
+func GetBatchHistoricData(ctx context.Context, symbols []listing.Symbol, opts ...func(*historic.Options) error) *BatchResult[*historic.Data] {
+	return getBatch(ctx, "GetBatchHistoricData", symbols, func(ctx context.Context, symbol string) (*historic.Data, error) {
+		return GetHistoricData(ctx, symbol, opts...)
+	})
+}
+
+// GetBatchDividendData returns dividend data for each of the symbols, using the api_key stored in the context.
+func GetBatchDividendData(ctx context.Context, symbols []listing.Symbol) *BatchResult[*historic.DividendData] {
+	return getBatch(ctx, "GetBatchDividendData", symbols, GetDividendData)
+}
+
+// GetBatchQuotes returns the latest quote for each of the symbols, using the api_key stored in the context.
+func GetBatchQuotes(ctx context.Context, symbols []listing.Symbol) *BatchResult[*quote.Data] {
+	return getBatch(ctx, "GetBatchQuotes", symbols, GetQuote)
+}
+
+// GetBatchIntradayData returns intraday data for each of the symbols, using the api_key stored in the context.
+// Use GetBatchQuotes if only the latest price of each symbol is required.
+func GetBatchIntradayData(ctx context.Context, symbols []listing.Symbol, opts ...func(*intraday.Options) error) *BatchResult[*intraday.Data] {
+	return getBatch(ctx, "GetBatchIntradayData", symbols, func(ctx context.Context, symbol string) (*intraday.Data, error) {
+		return GetIntradayData(ctx, symbol, opts...)
+	})
+}
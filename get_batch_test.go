@@ -0,0 +1,90 @@
+package alphav
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/listing"
+)
+
+func TestGetBatchContextEnded(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(Initialise(context.Background(), "A KEY"))
+	cancel()
+
+	symbols := []listing.Symbol{"IBM", "AAPL"}
+
+	result := GetBatchDividendData(ctx, symbols)
+
+	if len(result.Data) != 0 || len(result.Errors) != len(symbols) {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	for _, symbol := range symbols {
+		if !errors.Is(result.Errors[symbol], common.ErrContextEnded) {
+			t.Fatalf("%s: expected ErrContextEnded, got %v", symbol, result.Errors[symbol])
+		}
+	}
+}
+
+func TestGetBatchSymbols(t *testing.T) {
+
+	r := recordRequests(t)
+
+	ctx := Initialise(context.Background(), "KEY")
+
+	// Share classes from a listing can be requested, but other unusable listing symbols fail without a request
+	symbols := []listing.Symbol{"BC/PA", "NXT(EXP20091224)"}
+
+	result := GetBatchDividendData(ctx, symbols)
+
+	if len(result.Data) != 0 || len(result.Errors) != len(symbols) {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	if !errors.Is(result.Errors["BC/PA"], common.ErrRemoteCallError) {
+		t.Fatalf("BC/PA: expected request to be made, got %v", result.Errors["BC/PA"])
+	}
+	if !errors.Is(result.Errors["NXT(EXP20091224)"], common.ErrInvalidSymbol) {
+		t.Fatalf("NXT(EXP20091224): expected ErrInvalidSymbol, got %v", result.Errors["NXT(EXP20091224)"])
+	}
+
+	if len(r.urls) != 1 || r.urls[0] != "https://www.alphavantage.co/query?apikey=KEY&function=DIVIDENDS&symbol=BC%2FPA" {
+		t.Fatalf("unexpected requests: %v", r.urls)
+	}
+}
+
+func TestGetBatchInterval(t *testing.T) {
+
+	r := recordRequests(t)
+
+	ctx := WithBatchInterval(Initialise(context.Background(), "KEY"), 50*time.Millisecond)
+
+	symbols := []listing.Symbol{"IBM", "AAPL", "MSFT"}
+
+	start := time.Now()
+	GetBatchDividendData(ctx, symbols)
+
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Fatalf("expected a pause between requests, took %v", elapsed)
+	}
+	if len(r.urls) != len(symbols) {
+		t.Fatalf("unexpected requests: %v", r.urls)
+	}
+
+	// The context ending during a pause stops the remaining requests
+	ctx, cancel := context.WithTimeout(WithBatchInterval(Initialise(context.Background(), "KEY"), time.Hour), 50*time.Millisecond)
+	defer cancel()
+
+	result := GetBatchDividendData(ctx, symbols)
+
+	if len(r.urls) != len(symbols)+1 {
+		t.Fatalf("unexpected requests: %v", r.urls)
+	}
+	for _, symbol := range symbols[1:] {
+		if !errors.Is(result.Errors[symbol], common.ErrContextEnded) {
+			t.Fatalf("%s: expected ErrContextEnded, got %v", symbol, result.Errors[symbol])
+		}
+	}
+}
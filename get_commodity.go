@@ -0,0 +1,35 @@
+package alphav
+
+import (
+	"context"
+
+	"github.com/gford1000-go/alphav/commodities"
+	"github.com/gford1000-go/alphav/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetCommodityData returns the price history of the specified commodity, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/
+// for the commodity functions, such as WTI and COPPER
+func GetCommodityData(ctx context.Context, commodity commodities.Commodity, opts ...func(*commodities.Options) error) (*commodities.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetCommodityData")
+	defer span.End()
+
+	if !commodity.IsValid() {
+		return nil, commodities.ErrInvalidCommodity
+	}
+
+	span.SetAttributes(attribute.String("Function", commodity.Function()))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return commodities.GetData(commodity, apiKey, opts...)
+
+}
@@ -0,0 +1,94 @@
+package alphav
+
+import (
+	"context"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/crypto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetCryptoDaily returns the daily history for the specified digital currency, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/
+// for DIGITAL_CURRENCY_DAILY
+func GetCryptoDaily(ctx context.Context, symbol string, opts ...func(*crypto.Options) error) (*crypto.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetCryptoDaily")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.GetDaily(symbol, apiKey, opts...)
+
+}
+
+// GetCryptoWeekly returns the weekly history for the specified digital currency, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/
+// for DIGITAL_CURRENCY_WEEKLY
+func GetCryptoWeekly(ctx context.Context, symbol string, opts ...func(*crypto.Options) error) (*crypto.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetCryptoWeekly")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.GetWeekly(symbol, apiKey, opts...)
+
+}
+
+// GetCryptoMonthly returns the monthly history for the specified digital currency, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/
+// for DIGITAL_CURRENCY_MONTHLY
+func GetCryptoMonthly(ctx context.Context, symbol string, opts ...func(*crypto.Options) error) (*crypto.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetCryptoMonthly")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.GetMonthly(symbol, apiKey, opts...)
+
+}
+
+// GetCryptoIntraday returns intraday bars for the specified digital currency, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/
+// for CRYPTO_INTRADAY
+func GetCryptoIntraday(ctx context.Context, symbol string, opts ...func(*crypto.Options) error) (*crypto.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetCryptoIntraday")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.GetIntraday(symbol, apiKey, opts...)
+
+}
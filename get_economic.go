@@ -0,0 +1,35 @@
+package alphav
+
+import (
+	"context"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/economic"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetEconomicIndicator returns the history of the specified US economic indicator, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/
+// for the economic indicator functions, such as REAL_GDP and TREASURY_YIELD
+func GetEconomicIndicator(ctx context.Context, indicator economic.Indicator, opts ...func(*economic.Options) error) (*economic.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetEconomicIndicator")
+	defer span.End()
+
+	if !indicator.IsValid() {
+		return nil, economic.ErrInvalidIndicator
+	}
+
+	span.SetAttributes(attribute.String("Function", indicator.Function()))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return economic.GetData(indicator, apiKey, opts...)
+
+}
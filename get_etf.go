@@ -0,0 +1,29 @@
+package alphav
+
+import (
+	"context"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/etf"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetETFProfile returns the profile, sector allocation and holdings of the ETF, using the api_key stored in the context.
+func GetETFProfile(ctx context.Context, symbol string) (*etf.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetETFProfile")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return etf.GetProfile(symbol, apiKey)
+
+}
@@ -0,0 +1,131 @@
+package alphav
+
+import (
+	"context"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/fundamentals"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetCompanyOverview returns the company information and key metrics for the specified symbol,
+// using the api_key stored in the context.
+// Uses OVERVIEW function - see https://www.alphavantage.co/documentation/
+func GetCompanyOverview(ctx context.Context, symbol string) (*fundamentals.Overview, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetCompanyOverview")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fundamentals.GetOverview(symbol, apiKey)
+
+}
+
+// GetIncomeStatement returns the annual and quarterly income statements for the specified symbol,
+// using the api_key stored in the context.
+// Uses INCOME_STATEMENT function - see https://www.alphavantage.co/documentation/
+func GetIncomeStatement(ctx context.Context, symbol string) (*fundamentals.Statement, error) {
+	return getStatement(ctx, "GetIncomeStatement", symbol, fundamentals.IncomeStatement)
+}
+
+// GetBalanceSheet returns the annual and quarterly balance sheets for the specified symbol,
+// using the api_key stored in the context.
+// Uses BALANCE_SHEET function - see https://www.alphavantage.co/documentation/
+func GetBalanceSheet(ctx context.Context, symbol string) (*fundamentals.Statement, error) {
+	return getStatement(ctx, "GetBalanceSheet", symbol, fundamentals.BalanceSheet)
+}
+
+// GetCashFlow returns the annual and quarterly cash flow statements for the specified symbol,
+// using the api_key stored in the context.
+// Uses CASH_FLOW function - see https://www.alphavantage.co/documentation/
+func GetCashFlow(ctx context.Context, symbol string) (*fundamentals.Statement, error) {
+	return getStatement(ctx, "GetCashFlow", symbol, fundamentals.CashFlow)
+}
+
+func getStatement(ctx context.Context, spanName, symbol string, statementType fundamentals.StatementType) (*fundamentals.Statement, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, spanName)
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fundamentals.GetStatement(symbol, statementType, apiKey)
+
+}
+
+// GetEarnings returns the annual and quarterly earnings history for the specified symbol,
+// using the api_key stored in the context.
+// Uses EARNINGS function - see https://www.alphavantage.co/documentation/
+func GetEarnings(ctx context.Context, symbol string) (*fundamentals.Earnings, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetEarnings")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fundamentals.GetEarnings(symbol, apiKey)
+
+}
+
+// GetEarningsCalendar returns the expected earnings announcements, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/
+// for EARNINGS_CALENDAR
+func GetEarningsCalendar(ctx context.Context, opts ...func(*fundamentals.CalendarOptions) error) (*fundamentals.EarningsCalendar, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetEarningsCalendar")
+	defer span.End()
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fundamentals.GetEarningsCalendar(apiKey, opts...)
+
+}
+
+// GetEarningsCallTranscript returns the transcript of the earnings call for the specified symbol, fiscal year
+// and quarter (1 to 4), using the api_key stored in the context.
+// Uses EARNINGS_CALL_TRANSCRIPT function - see https://www.alphavantage.co/documentation/
+func GetEarningsCallTranscript(ctx context.Context, symbol string, year, quarter int) (*fundamentals.Transcript, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetEarningsCallTranscript")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol), attribute.Int("Year", year), attribute.Int("Quarter", quarter))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fundamentals.GetTranscript(symbol, year, quarter, apiKey)
+
+}
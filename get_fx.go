@@ -51,3 +51,67 @@ func GetIntradayFX(ctx context.Context, fromCurrency, toCurrency string) (*fx.In
 	return fx.GetIntraday(fromCurrency, toCurrency, apiKey)
 
 }
+
+// GetIntradayFXSeries returns intraday bars for the specified currency pair, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/
+// for FX_INTRADAY
+func GetIntradayFXSeries(ctx context.Context, fromCurrency, toCurrency string, opts ...func(*fx.Options) error) (*fx.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetIntradayFXSeries")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("FromCurrency", fromCurrency))
+	span.SetAttributes(attribute.String("ToCurrency", toCurrency))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fx.GetIntradaySeries(fromCurrency, toCurrency, apiKey, opts...)
+
+}
+
+// GetWeeklyFX returns the weekly history for the specified currency pair, using the api_key stored in the context.
+// This uses FX_WEEKLY from https://www.alphavantage.co/documentation/
+func GetWeeklyFX(ctx context.Context, fromCurrency, toCurrency string, opts ...func(*fx.Options) error) (*fx.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetWeeklyFX")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("FromCurrency", fromCurrency))
+	span.SetAttributes(attribute.String("ToCurrency", toCurrency))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fx.GetWeekly(fromCurrency, toCurrency, apiKey, opts...)
+
+}
+
+// GetMonthlyFX returns the monthly history for the specified currency pair, using the api_key stored in the context.
+// This uses FX_MONTHLY from https://www.alphavantage.co/documentation/
+func GetMonthlyFX(ctx context.Context, fromCurrency, toCurrency string, opts ...func(*fx.Options) error) (*fx.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetMonthlyFX")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("FromCurrency", fromCurrency))
+	span.SetAttributes(attribute.String("ToCurrency", toCurrency))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return fx.GetMonthly(fromCurrency, toCurrency, apiKey, opts...)
+
+}
@@ -49,3 +49,49 @@ func GetDividendData(ctx context.Context, symbol string) (*historic.DividendData
 	return historic.GetDividends(symbol, apiKey)
 
 }
+
+// GetSplitData returns split data for the specified symbol, using the api_key stored in the context.
+// Uses SPLITS function - see https://www.alphavantage.co/documentation/
+func GetSplitData(ctx context.Context, symbol string) (*historic.SplitData, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetSplitData")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return historic.GetSplits(symbol, apiKey)
+
+}
+
+// GetCorporateActions returns the dividends and splits for the specified symbol as a single chronological
+// series, using the api_key stored in the context.
+// Uses DIVIDENDS and SPLITS functions - see https://www.alphavantage.co/documentation/
+func GetCorporateActions(ctx context.Context, symbol string) (*historic.CorporateActionData, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetCorporateActions")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	dividends, err := GetDividendData(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := GetSplitData(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return historic.MergeCorporateActions(dividends, splits)
+
+}
@@ -0,0 +1,29 @@
+package alphav
+
+import (
+	"context"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/insider"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetInsiderTransactions returns the transactions of insiders in the symbol, using the api_key stored in the context.
+func GetInsiderTransactions(ctx context.Context, symbol string) (*insider.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetInsiderTransactions")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return insider.GetData(symbol, apiKey)
+
+}
@@ -2,13 +2,15 @@ package alphav
 
 import (
 	"context"
+	"iter"
 
 	"github.com/gford1000-go/alphav/common"
 	"github.com/gford1000-go/alphav/listing"
 	"go.opentelemetry.io/otel"
 )
 
-// GetActiveListing returns
+// GetActiveListing returns the actively traded symbols, using the api_key stored in the context.
+// Uses LISTING_STATUS function - see https://www.alphavantage.co/documentation/
 func GetActiveListing(ctx context.Context, opts ...func(*listing.Options) error) (*listing.Data, error) {
 
 	tracer := otel.Tracer(common.TracerName)
@@ -23,3 +25,62 @@ func GetActiveListing(ctx context.Context, opts ...func(*listing.Options) error)
 
 	return listing.GetActiveListing(apiKey, opts...)
 }
+
+// GetListing returns the active or delisted symbols, optionally as of a historic date, using the api_key stored in the context.
+// Uses LISTING_STATUS function - see https://www.alphavantage.co/documentation/
+func GetListing(ctx context.Context, opts ...func(*listing.Options) error) (*listing.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetListing")
+	defer span.End()
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return listing.GetListing(apiKey, opts...)
+}
+
+// StreamListing returns an iterator over the active or delisted symbols, using the api_key stored in the context.
+// Entries are parsed as they are read, so that the full listing can be processed without being held in memory.
+// The trace span covers the iteration, ending when it completes or is stopped early.
+func StreamListing(ctx context.Context, opts ...func(*listing.Options) error) iter.Seq2[*listing.Info, error] {
+	return func(yield func(*listing.Info, error) bool) {
+
+		tracer := otel.Tracer(common.TracerName)
+
+		ctx, span := tracer.Start(ctx, "StreamListing")
+		defer span.End()
+
+		apiKey, err := getAPIKey(ctx)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		for info, err := range listing.StreamListing(apiKey, opts...) {
+			if !yield(info, err) {
+				return
+			}
+		}
+	}
+}
+
+// GetIPOCalendar returns the listings expected to IPO in the next three months, using the api_key stored in the context.
+// Uses IPO_CALENDAR function - see https://www.alphavantage.co/documentation/
+func GetIPOCalendar(ctx context.Context, opts ...func(*listing.Options) error) (*listing.IPOCalendar, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetIPOCalendar")
+	defer span.End()
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return listing.GetIPOCalendar(apiKey, opts...)
+}
@@ -0,0 +1,26 @@
+package alphav
+
+import (
+	"context"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/movers"
+	"go.opentelemetry.io/otel"
+)
+
+// GetTopMovers returns the top gainers, top losers and most actively traded US tickers, using the api_key stored in the context.
+func GetTopMovers(ctx context.Context) (*movers.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetTopMovers")
+	defer span.End()
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return movers.GetData(apiKey)
+
+}
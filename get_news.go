@@ -0,0 +1,27 @@
+package alphav
+
+import (
+	"context"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/news"
+	"go.opentelemetry.io/otel"
+)
+
+// GetNewsSentiment returns news articles with their overall and per-ticker sentiment, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/#news-sentiment
+func GetNewsSentiment(ctx context.Context, opts ...func(*news.Options) error) (*news.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetNewsSentiment")
+	defer span.End()
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return news.GetData(apiKey, opts...)
+
+}
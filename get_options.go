@@ -0,0 +1,50 @@
+package alphav
+
+import (
+	"context"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/options"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetRealtimeOptions returns the realtime option chain for the symbol, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/#realtime-options
+func GetRealtimeOptions(ctx context.Context, symbol string, opts ...func(*options.Options) error) (*options.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetRealtimeOptions")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return options.GetRealtime(symbol, apiKey, opts...)
+
+}
+
+// GetHistoricalOptions returns the option chain for the symbol at the end of a trading date, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/#historical-options
+func GetHistoricalOptions(ctx context.Context, symbol string, opts ...func(*options.Options) error) (*options.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetHistoricalOptions")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return options.GetHistorical(symbol, apiKey, opts...)
+
+}
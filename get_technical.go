@@ -0,0 +1,35 @@
+package alphav
+
+import (
+	"context"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/technical"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetTechnicalIndicator returns the server calculated technical indicator for the symbol, using the api_key stored in the context.
+// opts allows the behaviour of the call to be varied per the options in https://www.alphavantage.co/documentation/
+// for the technical indicator functions, such as SMA, MACD and BBANDS
+func GetTechnicalIndicator(ctx context.Context, indicator technical.Indicator, symbol string, opts ...func(*technical.Options) error) (*technical.Data, error) {
+
+	tracer := otel.Tracer(common.TracerName)
+
+	ctx, span := tracer.Start(ctx, "GetTechnicalIndicator")
+	defer span.End()
+
+	if !indicator.IsValid() {
+		return nil, technical.ErrInvalidIndicator
+	}
+
+	span.SetAttributes(attribute.String("Indicator", indicator.String()), attribute.String("Symbol", symbol))
+
+	apiKey, err := getAPIKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return technical.GetData(indicator, symbol, apiKey, opts...)
+
+}
@@ -0,0 +1,338 @@
+package alphav
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/analytics"
+	"github.com/gford1000-go/alphav/commodities"
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/crypto"
+	"github.com/gford1000-go/alphav/economic"
+	"github.com/gford1000-go/alphav/fundamentals"
+	"github.com/gford1000-go/alphav/fx"
+	"github.com/gford1000-go/alphav/historic"
+	"github.com/gford1000-go/alphav/intraday"
+	"github.com/gford1000-go/alphav/listing"
+	"github.com/gford1000-go/alphav/news"
+	"github.com/gford1000-go/alphav/options"
+	"github.com/gford1000-go/alphav/technical"
+)
+
+var errRecorded = errors.New("request recorded")
+
+// recordingTransport records the URL of each request instead of making it
+type recordingTransport struct {
+	urls []string
+}
+
+func (r *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.urls = append(r.urls, req.URL.String())
+	return nil, errRecorded
+}
+
+// recordRequests replaces the default transport for the duration of the test
+func recordRequests(t *testing.T) *recordingTransport {
+	t.Helper()
+
+	r := &recordingTransport{}
+	transport := http.DefaultTransport
+	http.DefaultTransport = r
+	t.Cleanup(func() { http.DefaultTransport = transport })
+	return r
+}
+
+func TestRequestURLs(t *testing.T) {
+
+	ctx := Initialise(context.Background(), "KEY")
+
+	const base = "https://www.alphavantage.co/query?apikey=KEY&"
+
+	tests := []struct {
+		name     string
+		call     func() error
+		expected string
+	}{
+		{
+			name: "TIME_SERIES_DAILY_ADJUSTED",
+			call: func() error {
+				_, err := GetHistoricData(ctx, "ibm", historic.WithAllAvailableHistory(true))
+				return err
+			},
+			expected: base + "function=TIME_SERIES_DAILY_ADJUSTED&outputsize=full&symbol=IBM",
+		},
+		{
+			name:     "TIME_SERIES_INTRADAY",
+			call:     func() error { _, err := GetIntradayData(ctx, "TSCO.LON", intraday.WithStartPoint(2024, 3)); return err },
+			expected: "https://www.alphavantage.co/query?adjusted=true&apikey=KEY&extended_hours=false&function=TIME_SERIES_INTRADAY&interval=5min&month=2024-03&outputsize=compact&symbol=TSCO.LON",
+		},
+		{
+			name:     "DIVIDENDS",
+			call:     func() error { _, err := GetDividendData(ctx, "BRK.B"); return err },
+			expected: base + "function=DIVIDENDS&symbol=BRK.B",
+		},
+		{
+			name:     "SPLITS",
+			call:     func() error { _, err := GetSplitData(ctx, "IBM"); return err },
+			expected: base + "function=SPLITS&symbol=IBM",
+		},
+		{
+			name: "LISTING_STATUS",
+			call: func() error {
+				_, err := GetListing(ctx, listing.WithState(listing.Delisted), listing.WithDate(time.Date(2014, 7, 10, 0, 0, 0, 0, time.UTC)))
+				return err
+			},
+			expected: base + "date=2014-07-10&function=LISTING_STATUS&state=delisted",
+		},
+		{
+			name:     "IPO_CALENDAR",
+			call:     func() error { _, err := GetIPOCalendar(ctx); return err },
+			expected: base + "function=IPO_CALENDAR",
+		},
+		{
+			name:     "FX_DAILY",
+			call:     func() error { _, err := GetFX(ctx, "eur", "usd"); return err },
+			expected: base + "from_symbol=EUR&function=FX_DAILY&outputsize=compact&to_symbol=USD",
+		},
+		{
+			name: "FX_INTRADAY",
+			call: func() error {
+				_, err := GetIntradayFXSeries(ctx, "EUR", "USD", fx.WithInterval(intraday.OneMin))
+				return err
+			},
+			expected: base + "from_symbol=EUR&function=FX_INTRADAY&interval=1min&outputsize=compact&to_symbol=USD",
+		},
+		{
+			name:     "FX_WEEKLY",
+			call:     func() error { _, err := GetWeeklyFX(ctx, "EUR", "USD"); return err },
+			expected: base + "from_symbol=EUR&function=FX_WEEKLY&to_symbol=USD",
+		},
+		{
+			name:     "FX_MONTHLY",
+			call:     func() error { _, err := GetMonthlyFX(ctx, "EUR", "USD"); return err },
+			expected: base + "from_symbol=EUR&function=FX_MONTHLY&to_symbol=USD",
+		},
+		{
+			name:     "CURRENCY_EXCHANGE_RATE",
+			call:     func() error { _, err := GetIntradayFX(ctx, "btc", "EUR"); return err },
+			expected: base + "from_currency=BTC&function=CURRENCY_EXCHANGE_RATE&to_currency=EUR",
+		},
+		{
+			name:     "DIGITAL_CURRENCY_DAILY",
+			call:     func() error { _, err := GetCryptoDaily(ctx, "btc", crypto.WithMarket("EUR")); return err },
+			expected: base + "function=DIGITAL_CURRENCY_DAILY&market=EUR&symbol=BTC",
+		},
+		{
+			name:     "DIGITAL_CURRENCY_WEEKLY",
+			call:     func() error { _, err := GetCryptoWeekly(ctx, "BTC"); return err },
+			expected: base + "function=DIGITAL_CURRENCY_WEEKLY&market=USD&symbol=BTC",
+		},
+		{
+			name:     "DIGITAL_CURRENCY_MONTHLY",
+			call:     func() error { _, err := GetCryptoMonthly(ctx, "BTC"); return err },
+			expected: base + "function=DIGITAL_CURRENCY_MONTHLY&market=USD&symbol=BTC",
+		},
+		{
+			name:     "CRYPTO_INTRADAY",
+			call:     func() error { _, err := GetCryptoIntraday(ctx, "ETH"); return err },
+			expected: base + "function=CRYPTO_INTRADAY&interval=5min&market=USD&outputsize=compact&symbol=ETH",
+		},
+		{
+			name: "WTI",
+			call: func() error {
+				_, err := GetCommodityData(ctx, commodities.WTI, commodities.WithInterval(common.Weekly))
+				return err
+			},
+			expected: base + "function=WTI&interval=weekly",
+		},
+		{
+			name: "TREASURY_YIELD",
+			call: func() error {
+				_, err := GetEconomicIndicator(ctx, economic.TreasuryYield, economic.WithMaturity(economic.TwoYear))
+				return err
+			},
+			expected: base + "function=TREASURY_YIELD&interval=monthly&maturity=2year",
+		},
+		{
+			name:     "CPI",
+			call:     func() error { _, err := GetEconomicIndicator(ctx, economic.CPI); return err },
+			expected: base + "function=CPI&interval=monthly",
+		},
+		{
+			name:     "OVERVIEW",
+			call:     func() error { _, err := GetCompanyOverview(ctx, "IBM"); return err },
+			expected: base + "function=OVERVIEW&symbol=IBM",
+		},
+		{
+			name:     "INCOME_STATEMENT",
+			call:     func() error { _, err := GetIncomeStatement(ctx, "IBM"); return err },
+			expected: base + "function=INCOME_STATEMENT&symbol=IBM",
+		},
+		{
+			name:     "BALANCE_SHEET",
+			call:     func() error { _, err := GetBalanceSheet(ctx, "IBM"); return err },
+			expected: base + "function=BALANCE_SHEET&symbol=IBM",
+		},
+		{
+			name:     "CASH_FLOW",
+			call:     func() error { _, err := GetCashFlow(ctx, "IBM"); return err },
+			expected: base + "function=CASH_FLOW&symbol=IBM",
+		},
+		{
+			name:     "EARNINGS",
+			call:     func() error { _, err := GetEarnings(ctx, "IBM"); return err },
+			expected: base + "function=EARNINGS&symbol=IBM",
+		},
+		{
+			name: "EARNINGS_CALENDAR",
+			call: func() error {
+				_, err := GetEarningsCalendar(ctx, fundamentals.WithHorizon(fundamentals.SixMonths), fundamentals.WithCalendarSymbol("IBM"))
+				return err
+			},
+			expected: base + "function=EARNINGS_CALENDAR&horizon=6month&symbol=IBM",
+		},
+		{
+			name:     "EARNINGS_CALL_TRANSCRIPT",
+			call:     func() error { _, err := GetEarningsCallTranscript(ctx, "IBM", 2024, 1); return err },
+			expected: base + "function=EARNINGS_CALL_TRANSCRIPT&quarter=2024Q1&symbol=IBM",
+		},
+		{
+			name:     "ETF_PROFILE",
+			call:     func() error { _, err := GetETFProfile(ctx, "qqq"); return err },
+			expected: base + "function=ETF_PROFILE&symbol=QQQ",
+		},
+		{
+			name:     "GLOBAL_QUOTE",
+			call:     func() error { _, err := GetQuote(ctx, "ibm"); return err },
+			expected: base + "function=GLOBAL_QUOTE&symbol=IBM",
+		},
+		{
+			name:     "INSIDER_TRANSACTIONS",
+			call:     func() error { _, err := GetInsiderTransactions(ctx, "IBM"); return err },
+			expected: base + "function=INSIDER_TRANSACTIONS&symbol=IBM",
+		},
+		{
+			name:     "NEWS_SENTIMENT",
+			call:     func() error { _, err := GetNewsSentiment(ctx, news.WithTickers("IBM", "FOREX:USD")); return err },
+			expected: base + "function=NEWS_SENTIMENT&limit=50&sort=LATEST&tickers=IBM%2CFOREX%3AUSD",
+		},
+		{
+			name:     "TOP_GAINERS_LOSERS",
+			call:     func() error { _, err := GetTopMovers(ctx); return err },
+			expected: base + "function=TOP_GAINERS_LOSERS",
+		},
+		{
+			name:     "REALTIME_OPTIONS",
+			call:     func() error { _, err := GetRealtimeOptions(ctx, "IBM", options.WithRequireGreeks(true)); return err },
+			expected: base + "function=REALTIME_OPTIONS&require_greeks=true&symbol=IBM",
+		},
+		{
+			name: "HISTORICAL_OPTIONS",
+			call: func() error {
+				_, err := GetHistoricalOptions(ctx, "IBM", options.WithDate(time.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC)))
+				return err
+			},
+			expected: base + "date=2024-01-17&function=HISTORICAL_OPTIONS&symbol=IBM",
+		},
+		{
+			name: "RSI",
+			call: func() error {
+				_, err := GetTechnicalIndicator(ctx, technical.RSI, "IBM", technical.WithTimePeriod(14))
+				return err
+			},
+			expected: base + "function=RSI&interval=daily&series_type=close&symbol=IBM&time_period=14",
+		},
+		{
+			name: "ANALYTICS_FIXED_WINDOW",
+			call: func() error {
+				_, err := GetFixedWindowAnalytics(ctx, []string{"AAPL", "IBM"}, []analytics.CalculationSpec{{Calculation: analytics.Mean}}, analytics.WithPeriod(1, common.Monthly))
+				return err
+			},
+			expected: "https://alphavantageapi.co/timeseries/analytics?CALCULATIONS=MEAN&INTERVAL=DAILY&OHLC=close&RANGE=1month&SYMBOLS=AAPL%2CIBM&apikey=KEY",
+		},
+		{
+			name: "ANALYTICS_SLIDING_WINDOW",
+			call: func() error {
+				_, err := GetSlidingWindowAnalytics(ctx, []string{"IBM"}, 20, []analytics.CalculationSpec{{Calculation: analytics.Mean}})
+				return err
+			},
+			expected: "https://alphavantageapi.co/timeseries/running_analytics?CALCULATIONS=MEAN&INTERVAL=DAILY&OHLC=close&RANGE=full&SYMBOLS=IBM&WINDOW_SIZE=20&apikey=KEY",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := recordRequests(t)
+
+			if err := test.call(); !errors.Is(err, common.ErrRemoteCallError) {
+				t.Fatalf("unexpected error: expected %v, got %v", common.ErrRemoteCallError, err)
+			}
+
+			if len(r.urls) != 1 || r.urls[0] != test.expected {
+				t.Fatalf("unexpected url:\nexpected %s\ngot      %v", test.expected, r.urls)
+			}
+		})
+	}
+}
+
+func TestRequestValidation(t *testing.T) {
+
+	ctx := Initialise(context.Background(), "KEY")
+
+	tests := []struct {
+		name     string
+		call     func() error
+		expected error
+	}{
+		{
+			name:     "symbol with parameter",
+			call:     func() error { _, err := GetHistoricData(ctx, "IBM&outputsize=full"); return err },
+			expected: common.ErrInvalidSymbol,
+		},
+		{
+			name:     "symbol with space",
+			call:     func() error { _, err := GetIntradayData(ctx, "IBM MSFT"); return err },
+			expected: common.ErrInvalidSymbol,
+		},
+		{
+			name:     "empty symbol",
+			call:     func() error { _, err := GetCompanyOverview(ctx, ""); return err },
+			expected: common.ErrInvalidSymbol,
+		},
+		{
+			name:     "currency with plus",
+			call:     func() error { _, err := GetIntradayFX(ctx, "EUR+", "USD"); return err },
+			expected: common.ErrInvalidParameter,
+		},
+		{
+			name:     "invalid indicator",
+			call:     func() error { _, err := GetTechnicalIndicator(ctx, technical.InvalidIndicator, "IBM"); return err },
+			expected: technical.ErrInvalidIndicator,
+		},
+		{
+			name: "analytics symbol",
+			call: func() error {
+				_, err := GetFixedWindowAnalytics(ctx, []string{"IBM,AAPL"}, []analytics.CalculationSpec{{Calculation: analytics.Mean}})
+				return err
+			},
+			expected: common.ErrInvalidSymbol,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := recordRequests(t)
+
+			if err := test.call(); !errors.Is(err, test.expected) {
+				t.Fatalf("unexpected error: expected %v, got %v", test.expected, err)
+			}
+
+			if len(r.urls) != 0 {
+				t.Fatalf("unexpected request: %v", r.urls)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package historic
+
+type CorporateActionType int
+
+const (
+	UnknownCorporateActionType CorporateActionType = iota
+	DividendAction
+	SplitAction
+	InvalidCorporateActionType
+)
+
+func (c CorporateActionType) String() string {
+	switch c {
+	case DividendAction:
+		return "dividend"
+	case SplitAction:
+		return "split"
+	default:
+		panic("invalid value of CorporateActionType")
+	}
+}
+
+func (c CorporateActionType) isValid() bool {
+	if c <= UnknownCorporateActionType || c >= InvalidCorporateActionType {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package historic
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// ErrSymbolMismatch indicates that the data provided to MergeCorporateActions relates to different symbols
+var ErrSymbolMismatch = errors.New("corporate action data is for different symbols")
+
+// MergeCorporateActions combines the dividend and split histories of a symbol into a single
+// chronological series, ordered most recent first.
+// Dividends are dated by their ex-dividend date, falling back to the payment, record and then
+// declaration dates if the earlier choices are undefined; dividends with no defined dates are omitted.
+// Either dividends or splits may be nil, but not both.
+func MergeCorporateActions(dividends *DividendData, splits *SplitData) (*CorporateActionData, error) {
+
+	if dividends == nil && splits == nil {
+		return nil, ErrInvalidData
+	}
+
+	var symbol string
+	if dividends != nil {
+		if dividends.Meta == nil {
+			return nil, ErrInvalidData
+		}
+		symbol = dividends.Meta.Symbol
+	}
+	if splits != nil {
+		if splits.Meta == nil {
+			return nil, ErrInvalidData
+		}
+		if dividends != nil && splits.Meta.Symbol != symbol {
+			return nil, ErrSymbolMismatch
+		}
+		symbol = splits.Meta.Symbol
+	}
+
+	ts := []*CorporateAction{}
+
+	if dividends != nil {
+		for _, v := range dividends.TimeSeries {
+			dt, ok := dividendActionDate(v)
+			if !ok {
+				continue
+			}
+			ts = append(ts, &CorporateAction{
+				Date:     dt,
+				Type:     DividendAction,
+				Dividend: v,
+			})
+		}
+	}
+
+	if splits != nil {
+		for _, v := range splits.TimeSeries {
+			ts = append(ts, &CorporateAction{
+				Date:  v.EffectiveDate,
+				Type:  SplitAction,
+				Split: v,
+			})
+		}
+	}
+
+	// Sort is descending ... most recent date first, with splits ahead of dividends on the same date
+	slices.SortStableFunc(ts, func(a, b *CorporateAction) int {
+		if c := b.Date.Compare(a.Date); c != 0 {
+			return c
+		}
+		return int(b.Type) - int(a.Type)
+	})
+
+	result := &CorporateActionData{
+		Meta: &Metadata{
+			Symbol: symbol,
+		},
+		TimeSeries: ts,
+	}
+
+	if len(ts) > 0 {
+		result.Meta.DataRange = &DataRange{
+			Start: ts[len(ts)-1].Date,
+			End:   ts[0].Date,
+		}
+		result.Meta.LastRefresh = ts[0].Date
+	}
+
+	return result, nil
+}
+
+// dividendActionDate returns the date on which the dividend should be positioned in the combined series
+func dividendActionDate(d *DividendElement) (time.Time, bool) {
+	for _, dt := range []DividendDate{d.ExDividendDate, d.PaymentDate, d.RecordDate, d.DeclarationDate} {
+		if !dt.IsUndefined() {
+			return time.Time(dt), true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,63 @@
+package historic
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestMergeCorporateActions(t *testing.T) {
+
+	divs, _ := os.ReadFile("../example_data/ibm_dividends.json")
+	splits, _ := os.ReadFile("../example_data/ibm_splits.json")
+
+	divData, err := parseDividendsJSON(divs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	splitData, err := parseSplitsJSON(splits)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := MergeCorporateActions(divData, splitData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.TimeSeries) != len(divData.TimeSeries)+len(splitData.TimeSeries) {
+		t.Fatalf("unexpected length of time series: expected %d, got %d", len(divData.TimeSeries)+len(splitData.TimeSeries), len(result.TimeSeries))
+	}
+
+	var numSplits int
+	for i, v := range result.TimeSeries {
+		if i > 0 && v.Date.After(result.TimeSeries[i-1].Date) {
+			t.Fatalf("time series is not in descending order at element %d", i)
+		}
+		if v.Type == SplitAction {
+			numSplits++
+		}
+	}
+
+	if numSplits != len(splitData.TimeSeries) {
+		t.Fatalf("expected %d splits, got %d", len(splitData.TimeSeries), numSplits)
+	}
+
+	dt, _ := common.ParseDate("2025-08-08")
+	if result.Meta.LastRefresh != dt {
+		t.Fatalf("expected last refresh '2025-08-08', got '%s'", result.Meta.LastRefresh)
+	}
+}
+
+func TestMergeCorporateActions_1(t *testing.T) {
+
+	_, err := MergeCorporateActions(
+		&DividendData{Meta: &Metadata{Symbol: "IBM"}},
+		&SplitData{Meta: &Metadata{Symbol: "MSFT"}})
+
+	if err != ErrSymbolMismatch {
+		t.Fatalf("unexpected error: expected %v, got %v", ErrSymbolMismatch, err)
+	}
+}
@@ -97,3 +97,39 @@ func (d *DividendData) isValid() bool {
 	}
 	return true
 }
+
+// SplitElement stores information related to a single stock split
+type SplitElement struct {
+	// EffectiveDate is the date from which the split applies
+	EffectiveDate time.Time
+	// SplitFactor is the number of new shares issued for each existing share
+	SplitFactor float64
+}
+
+// SplitData is the history of stock splits for the Symbol
+type SplitData struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// TimeSeries is an ordered set of data
+	TimeSeries []*SplitElement
+}
+
+// CorporateAction is a single dividend or split event for the Symbol
+type CorporateAction struct {
+	// Date is the date on which the action affects the price of the Symbol
+	Date time.Time
+	// Type identifies which of Dividend or Split is populated
+	Type CorporateActionType
+	// Dividend is set when Type is DividendAction
+	Dividend *DividendElement
+	// Split is set when Type is SplitAction
+	Split *SplitElement
+}
+
+// CorporateActionData is the combined history of dividends and splits for the Symbol
+type CorporateActionData struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// TimeSeries is an ordered set of data
+	TimeSeries []*CorporateAction
+}
@@ -44,7 +44,13 @@ func GetData(symbol, apiKey string, opts ...func(*Options) error) (*Data, error)
 		outputsize = "full"
 	}
 
-	url := fmt.Sprintf("https://www.alphavantage.co/query?function=TIME_SERIES_DAILY_ADJUSTED&symbol=%s&outputsize=%s&apikey=%s", symbol, outputsize, apiKey)
+	url, err := common.NewQuery("TIME_SERIES_DAILY_ADJUSTED").
+		Symbol(symbol).
+		Set("outputsize", outputsize).
+		URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := http.Get(url)
 	if err != nil {
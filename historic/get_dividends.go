@@ -32,7 +32,10 @@ type respDivJSON struct {
 // GetDividends uses the provided apiKey to retrieve dividend details for the symbol
 func GetDividends(symbol, apiKey string) (*DividendData, error) {
 
-	url := fmt.Sprintf("https://www.alphavantage.co/query?function=DIVIDENDS&symbol=%s&apikey=%s", symbol, apiKey)
+	url, err := common.NewQuery("DIVIDENDS").Symbol(symbol).URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := http.Get(url)
 	if err != nil {
@@ -66,8 +69,8 @@ func parseDividendsJSON(b []byte) (*DividendData, error) {
 	if d.Symbol == nil {
 		return nil, fmt.Errorf("api error: expected Symbol, got nil: %w", common.ErrRemoteCallError)
 	}
-	if d.Data == nil || len(*d.Data) == 0 {
-		return nil, fmt.Errorf("api error: expected Data, got empty list: %w", common.ErrRemoteCallError)
+	if d.Data == nil {
+		return nil, fmt.Errorf("api error: expected Data, got nil: %w", common.ErrRemoteCallError)
 	}
 
 	result := &DividendData{
@@ -84,9 +87,10 @@ func parseDividendsJSON(b []byte) (*DividendData, error) {
 	return result, nil
 }
 
+// parseDividendTimeSeries allows an empty list, since many symbols have never paid a dividend
 func parseDividendTimeSeries(d *[]*respDivJSON, r *DividendData) error {
 
-	if d == nil || len(*d) == 0 {
+	if d == nil {
 		return errors.New("no data available to be parsed")
 	}
 
@@ -172,7 +176,9 @@ func parseDividendTimeSeries(d *[]*respDivJSON, r *DividendData) error {
 	})
 
 	r.TimeSeries = ts
-	r.Meta.DataRange = dtRng      // Range based on ex-div dates
-	r.Meta.LastRefresh = dtUpdate // LastRefresh based on latest RecordDate
+	if len(ts) > 0 {
+		r.Meta.DataRange = dtRng      // Range based on ex-div dates
+		r.Meta.LastRefresh = dtUpdate // LastRefresh based on latest RecordDate
+	}
 	return nil
 }
@@ -52,3 +52,29 @@ func TestParseDividendsJSON(t *testing.T) {
 		t.Fatalf("expected first data element to have amount: '%v', got '%v'", earliest, result.TimeSeries[len(result.TimeSeries)-1].Amount)
 	}
 }
+
+func TestParseDividendsJSON_NoDividends(t *testing.T) {
+
+	result, err := parseDividendsJSON([]byte(`{"symbol": "BRK-B", "data": []}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Meta.Symbol != "BRK-B" || len(result.TimeSeries) != 0 || result.Meta.DataRange != nil {
+		t.Fatalf("unexpected result: %v", result)
+	}
+
+	splits, err := parseSplitsJSON([]byte(`{"symbol": "BRK-B", "data": [{"effective_date": "2010-01-21", "split_factor": "50.0000"}]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actions, err := MergeCorporateActions(result, splits)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(actions.TimeSeries) != 1 || actions.TimeSeries[0].Type != SplitAction {
+		t.Fatalf("expected a single split, got %v", actions.TimeSeries)
+	}
+}
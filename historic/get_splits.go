@@ -0,0 +1,142 @@
+package historic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// respSplitsJSON captures all possible return JSON
+type respSplitsJSON struct {
+	Info   *string           `json:"Information"`
+	Err    *string           `json:"Error Message"`
+	Symbol *string           `json:"Symbol"`
+	Data   *[]*respSplitJSON `json:"Data"`
+}
+
+type respSplitJSON struct {
+	EffectiveDate string `json:"effective_date"`
+	SplitFactor   string `json:"split_factor"`
+}
+
+// GetSplits uses the provided apiKey to retrieve split details for the symbol
+func GetSplits(symbol, apiKey string) (*SplitData, error) {
+
+	url, err := common.NewQuery("SPLITS").Symbol(symbol).URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseSplitsJSON(b)
+}
+
+func parseSplitsJSON(b []byte) (*SplitData, error) {
+	var d respSplitsJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+	if d.Symbol == nil {
+		return nil, fmt.Errorf("api error: expected Symbol, got nil: %w", common.ErrRemoteCallError)
+	}
+	if d.Data == nil {
+		return nil, fmt.Errorf("api error: expected Data, got nil: %w", common.ErrRemoteCallError)
+	}
+
+	result := &SplitData{
+		Meta: &Metadata{
+			Symbol: *d.Symbol,
+		},
+		TimeSeries: []*SplitElement{},
+	}
+
+	if err := parseSplitTimeSeries(d.Data, result); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrTimeSeriesParseError)
+	}
+
+	return result, nil
+}
+
+// parseSplitTimeSeries allows an empty list, since most symbols have never split
+func parseSplitTimeSeries(d *[]*respSplitJSON, r *SplitData) error {
+
+	if d == nil {
+		return errors.New("no data available to be parsed")
+	}
+
+	ts := []*SplitElement{}
+
+	dtRng := &DataRange{
+		Start: latestDate,
+		End:   earliestDate,
+	}
+
+	for i, v := range *d {
+		if v == nil {
+			return fmt.Errorf("v is nil for element %d", i)
+		}
+
+		t, err := common.ParseDate(v.EffectiveDate)
+		if err != nil {
+			return err
+		}
+
+		if t.Before(dtRng.Start) {
+			dtRng.Start = t
+		}
+		if t.After(dtRng.End) {
+			dtRng.End = t
+		}
+
+		value, err := strconv.ParseFloat(v.SplitFactor, 64)
+		if err != nil {
+			return fmt.Errorf("error parsing split factor (%s) for element %d: %v", v.SplitFactor, i, err)
+		}
+		if value <= 0 {
+			return fmt.Errorf("invalid split factor (%s) for element %d", v.SplitFactor, i)
+		}
+
+		ts = append(ts, &SplitElement{
+			EffectiveDate: t,
+			SplitFactor:   value,
+		})
+	}
+
+	// Sort is descending ... most recent date first
+	slices.SortFunc(ts, func(a, b *SplitElement) int {
+		return b.EffectiveDate.Compare(a.EffectiveDate)
+	})
+
+	r.TimeSeries = ts
+	if len(ts) > 0 {
+		r.Meta.DataRange = dtRng
+		r.Meta.LastRefresh = dtRng.End
+	}
+	return nil
+}
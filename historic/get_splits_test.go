@@ -0,0 +1,56 @@
+package historic
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestParseSplitsJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_splits.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseSplitsJSON(data)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if len(result.TimeSeries) != 3 {
+		t.Fatalf("expected 3 splits, got %d", len(result.TimeSeries))
+	}
+
+	if result.Meta.Symbol != "IBM" {
+		t.Fatalf("expected symbol 'IBM', got '%s'", result.Meta.Symbol)
+	}
+
+	last, _ := common.ParseDate("2021-11-04")
+	if result.Meta.DataRange.End != last {
+		t.Fatalf("expected data range end '2021-11-04', got '%s'", result.Meta.DataRange.End)
+	}
+
+	first, _ := common.ParseDate("1997-05-28")
+	if result.Meta.DataRange.Start != first {
+		t.Fatalf("expected data range start '1997-05-28', got '%s'", result.Meta.DataRange.Start)
+	}
+
+	latest := 1.046
+	if !common.EqualFloat64(latest, result.TimeSeries[0].SplitFactor, 4) {
+		t.Fatalf("expected latest data element to have split factor: '%v', got '%v'", latest, result.TimeSeries[0].SplitFactor)
+	}
+}
+
+func TestParseSplitsJSON_1(t *testing.T) {
+
+	result, err := parseSplitsJSON([]byte(`{"symbol": "NOSPLIT", "data": []}`))
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if len(result.TimeSeries) != 0 {
+		t.Fatalf("expected no splits, got %d", len(result.TimeSeries))
+	}
+}
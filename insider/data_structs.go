@@ -0,0 +1,114 @@
+package insider
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Symbol is the requested symbol for which data is retrieved
+	Symbol string
+}
+
+// Transaction is a single transaction by an insider
+type Transaction struct {
+	// Date is the date of the transaction
+	Date time.Time
+	// Symbol is the symbol of the company
+	Symbol string
+	// Executive is the name of the insider
+	Executive string
+	// Title is the role of the insider, e.g. "Director"
+	Title string
+	// SecurityType is the type of security, e.g. "Common Stock"
+	SecurityType string
+	// Type identifies whether the shares were acquired or disposed of
+	Type TransactionType
+	// Shares is the number of shares in the transaction
+	Shares float64
+	// SharePrice is the price of the transaction, which is undefined if not reported (e.g. grants)
+	SharePrice common.OptionalFloat64
+}
+
+// Data is the returned object from a call to GetData
+type Data struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// Transactions lists the transactions, most recent first
+	Transactions []*Transaction
+}
+
+// MonthlyActivity is the aggregated insider activity for a calendar month
+type MonthlyActivity struct {
+	// Month is the first day of the month
+	Month time.Time
+	// Transactions is the number of transactions in the month
+	Transactions int
+	// SharesAcquired is the total number of shares acquired in priced transactions
+	SharesAcquired float64
+	// SharesDisposed is the total number of shares disposed of in priced transactions
+	SharesDisposed float64
+	// NetShares is SharesAcquired less SharesDisposed; positive values indicate net buying
+	NetShares float64
+	// NetValue is the value of acquisitions less disposals
+	NetValue float64
+	// UnpricedAcquired is the total number of shares acquired without a reported price, e.g. grants and RSU vestings
+	UnpricedAcquired float64
+	// UnpricedDisposed is the total number of shares disposed of without a reported price
+	UnpricedDisposed float64
+}
+
+// Monthly aggregates the transactions into net insider buying or selling per month, most recent month first.
+// Only months with at least one transaction are included.  Transactions without a positive share price
+// (e.g. grants and RSU vestings) are not purchases or sales, so are reported separately from NetShares.
+func (d *Data) Monthly() []*MonthlyActivity {
+
+	m := map[time.Time]*MonthlyActivity{}
+	for _, t := range d.Transactions {
+		month := time.Date(t.Date.Year(), t.Date.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		a, ok := m[month]
+		if !ok {
+			a = &MonthlyActivity{Month: month}
+			m[month] = a
+		}
+
+		a.Transactions++
+
+		if t.SharePrice.IsUndefined() || t.SharePrice.Value <= 0 {
+			switch t.Type {
+			case Acquisition:
+				a.UnpricedAcquired += t.Shares
+			case Disposal:
+				a.UnpricedDisposed += t.Shares
+			}
+			continue
+		}
+
+		sign := 1.0
+		switch t.Type {
+		case Acquisition:
+			a.SharesAcquired += t.Shares
+		case Disposal:
+			a.SharesDisposed += t.Shares
+			sign = -1.0
+		}
+		a.NetShares += sign * t.Shares
+		a.NetValue += sign * t.Shares * t.SharePrice.Value
+	}
+
+	result := make([]*MonthlyActivity, 0, len(m))
+	for _, a := range m {
+		result = append(result, a)
+	}
+
+	// Sort is descending ... most recent month first
+	slices.SortFunc(result, func(a, b *MonthlyActivity) int {
+		return b.Month.Compare(a.Month)
+	})
+
+	return result
+}
@@ -0,0 +1,134 @@
+package insider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// respJSON captures all possible return JSON
+type respJSON struct {
+	Info *string                 `json:"Information"`
+	Err  *string                 `json:"Error Message"`
+	Data *[]*respTransactionJSON `json:"data"`
+}
+
+type respTransactionJSON struct {
+	TransactionDate       string `json:"transaction_date"`
+	Ticker                string `json:"ticker"`
+	Executive             string `json:"executive"`
+	ExecutiveTitle        string `json:"executive_title"`
+	SecurityType          string `json:"security_type"`
+	AcquisitionOrDisposal string `json:"acquisition_or_disposal"`
+	Shares                string `json:"shares"`
+	SharePrice            string `json:"share_price"`
+}
+
+// ErrInvalidTransactionType returned when a transaction is neither an acquisition nor a disposal
+var ErrInvalidTransactionType = errors.New("invalid transaction type")
+
+// GetData uses the provided apiKey to retrieve the insider transactions for the symbol
+func GetData(symbol, apiKey string) (*Data, error) {
+
+	url, err := common.NewQuery("INSIDER_TRANSACTIONS").Symbol(symbol).URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseJSON(b, strings.ToUpper(symbol))
+}
+
+func parseJSON(b []byte, symbol string) (*Data, error) {
+	var d respJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+	if d.Data == nil {
+		return nil, fmt.Errorf("no data available to be parsed: %w", common.ErrParseError)
+	}
+
+	result := &Data{
+		Meta: &Metadata{
+			Symbol: symbol,
+		},
+		Transactions: []*Transaction{},
+	}
+
+	for _, t := range *d.Data {
+		tx, err := parseTransaction(t)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+		}
+		result.Transactions = append(result.Transactions, tx)
+	}
+
+	// Sort is descending ... most recent date first
+	slices.SortStableFunc(result.Transactions, func(a, b *Transaction) int {
+		return b.Date.Compare(a.Date)
+	})
+
+	return result, nil
+}
+
+func parseTransaction(t *respTransactionJSON) (*Transaction, error) {
+
+	if t == nil {
+		return nil, errors.New("unexpected null transaction")
+	}
+
+	tx := &Transaction{
+		Symbol:       t.Ticker,
+		Executive:    t.Executive,
+		Title:        t.ExecutiveTitle,
+		SecurityType: t.SecurityType,
+	}
+
+	var err error
+	if tx.Date, err = time.Parse(time.DateOnly, t.TransactionDate); err != nil {
+		return nil, fmt.Errorf("invalid transaction date %s: %v", t.TransactionDate, err)
+	}
+	if tx.Type, err = parseTransactionType(t.AcquisitionOrDisposal); err != nil {
+		return nil, fmt.Errorf("%s on %s: %w", t.AcquisitionOrDisposal, t.TransactionDate, err)
+	}
+	if tx.Shares, err = strconv.ParseFloat(t.Shares, 64); err != nil {
+		return nil, fmt.Errorf("invalid shares %s on %s: %v", t.Shares, t.TransactionDate, err)
+	}
+	if tx.SharePrice, err = common.ParseOptionalFloat64(t.SharePrice); err != nil {
+		return nil, fmt.Errorf("invalid share price %s on %s: %v", t.SharePrice, t.TransactionDate, err)
+	}
+	// A zero price is reported for transactions without consideration, such as grants
+	if tx.SharePrice.Defined && tx.SharePrice.Value == 0 {
+		tx.SharePrice = common.OptionalFloat64{}
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,90 @@
+package insider
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestParseJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_insider_transactions.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseJSON(data, "IBM")
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if len(result.Transactions) != 5 {
+		t.Fatalf("expected 5 transactions, got %d", len(result.Transactions))
+	}
+
+	tx := result.Transactions[0]
+	if tx.Date != time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC) || tx.Type != Acquisition || tx.Title != "Director" {
+		t.Fatalf("unexpected first transaction: %v", tx)
+	}
+
+	if !common.EqualFloat64(240, tx.SharePrice.Value, 2) {
+		t.Fatalf("unexpected share price: %v", tx.SharePrice)
+	}
+
+	for _, tx := range result.Transactions[3:] {
+		if !tx.SharePrice.IsUndefined() {
+			t.Fatalf("expected undefined share price, got %v", tx.SharePrice)
+		}
+	}
+}
+
+func TestMonthly(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_insider_transactions.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseJSON(data, "IBM")
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	monthly := result.Monthly()
+	if len(monthly) != 2 {
+		t.Fatalf("expected 2 months, got %d", len(monthly))
+	}
+
+	aug := monthly[0]
+	if aug.Month != time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC) || aug.Transactions != 2 {
+		t.Fatalf("unexpected August activity: %v", aug)
+	}
+
+	if !common.EqualFloat64(600, aug.NetShares, 2) || !common.EqualFloat64(142000, aug.NetValue, 2) {
+		t.Fatalf("unexpected August net: %v, %v", aug.NetShares, aug.NetValue)
+	}
+
+	// Unpriced transactions (the zero-price RSU vesting and the disposal without a price) are excluded from net
+	jul := monthly[1]
+	if !common.EqualFloat64(0, jul.SharesAcquired, 2) || !common.EqualFloat64(1200, jul.SharesDisposed, 2) {
+		t.Fatalf("unexpected July shares: %v, %v", jul.SharesAcquired, jul.SharesDisposed)
+	}
+
+	if !common.EqualFloat64(5000, jul.UnpricedAcquired, 2) || !common.EqualFloat64(2000, jul.UnpricedDisposed, 2) {
+		t.Fatalf("unexpected July unpriced shares: %v, %v", jul.UnpricedAcquired, jul.UnpricedDisposed)
+	}
+
+	if !common.EqualFloat64(-1200, jul.NetShares, 2) || !common.EqualFloat64(-300600, jul.NetValue, 2) {
+		t.Fatalf("unexpected July net: %v, %v", jul.NetShares, jul.NetValue)
+	}
+}
+
+func TestParseJSON_1(t *testing.T) {
+
+	data := []byte(`{"data": [{"transaction_date": "2025-08-15", "acquisition_or_disposal": "X", "shares": "1"}]}`)
+	if _, err := parseJSON(data, "IBM"); err == nil {
+		t.Fatal("expected error for invalid transaction type, got nil")
+	}
+}
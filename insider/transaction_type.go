@@ -0,0 +1,42 @@
+package insider
+
+// TransactionType identifies whether shares were acquired or disposed of
+type TransactionType int
+
+const (
+	UnknownTransactionType TransactionType = iota
+	Acquisition
+	Disposal
+	InvalidTransactionType
+)
+
+func (t TransactionType) String() string {
+	switch t {
+	case Acquisition:
+		return "Acquisition"
+	case Disposal:
+		return "Disposal"
+	default:
+		panic("invalid value of TransactionType")
+	}
+}
+
+func (t TransactionType) toAVString() string {
+	switch t {
+	case Acquisition:
+		return "A"
+	case Disposal:
+		return "D"
+	default:
+		panic("invalid value of TransactionType")
+	}
+}
+
+func parseTransactionType(s string) (TransactionType, error) {
+	for t := UnknownTransactionType + 1; t < InvalidTransactionType; t++ {
+		if t.toAVString() == s {
+			return t, nil
+		}
+	}
+	return UnknownTransactionType, ErrInvalidTransactionType
+}
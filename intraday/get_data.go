@@ -48,12 +48,21 @@ func GetData(symbol, apiKey string, opts ...func(*Options) error) (*Data, error)
 		outputsize = "full"
 	}
 
-	month := ""
+	q := common.NewQuery("TIME_SERIES_INTRADAY").
+		Symbol(symbol).
+		Set("interval", o.Interval.String()).
+		SetBool("adjusted", o.Adjusted).
+		SetBool("extended_hours", o.ExtendedHours).
+		Set("outputsize", outputsize)
+
 	if o.FromYear > 0 && o.FromMonth > 0 {
-		month = fmt.Sprintf("&month=%d-%02d", o.FromYear, o.FromMonth)
+		q.Set("month", fmt.Sprintf("%d-%02d", o.FromYear, o.FromMonth))
 	}
 
-	url := fmt.Sprintf("https://www.alphavantage.co/query?function=TIME_SERIES_INTRADAY%s&symbol=%s&interval=%s&adjusted=%v&extended_hours=%v&outputsize=%s&apikey=%s", month, symbol, o.Interval, o.Adjusted, o.ExtendedHours, outputsize, apiKey)
+	url, err := q.URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := http.Get(url)
 	if err != nil {
@@ -123,7 +132,7 @@ func parseMetadata(m *metaJSON, r *Data, o *Options) error {
 		TimeZone:    m.TZ,
 	}
 
-	interval, err := parseInterval(m.Interval)
+	interval, err := ParseInterval(m.Interval)
 	if err != nil {
 		return err
 	}
@@ -31,7 +31,8 @@ func (i Interval) String() string {
 	}
 }
 
-func parseInterval(s string) (Interval, error) {
+// ParseInterval returns the Interval described by the Alpha Vantage string, such as "5min"
+func ParseInterval(s string) (Interval, error) {
 	switch s {
 	case "1min":
 		return OneMin, nil
@@ -48,7 +49,8 @@ func parseInterval(s string) (Interval, error) {
 	}
 }
 
-func (i Interval) isValid() bool {
+// IsValid returns true if the Interval can be used in a request
+func (i Interval) IsValid() bool {
 	if i <= UnknownInterval || i >= InvalidInterval {
 		return false
 	}
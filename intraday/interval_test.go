@@ -62,7 +62,7 @@ func TestInterval(t *testing.T) {
 			return // This occurs for panicking tests
 		}
 
-		v1, err := parseInterval(s)
+		v1, err := ParseInterval(s)
 		if err != nil {
 			t.Fatalf("unexpected parse failure for %s", s)
 		}
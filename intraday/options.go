@@ -28,7 +28,7 @@ type Options struct {
 // WithInterval sets the interval between elements
 func WithInterval(interval Interval) func(*Options) error {
 	return func(o *Options) error {
-		if !interval.isValid() {
+		if !interval.IsValid() {
 			return common.ErrInvalidInterval
 		}
 		o.Interval = interval
@@ -8,6 +8,15 @@ const (
 	UnknownAssetType AssetType = iota
 	Stock
 	ETF
+	MutualFund
+	ClosedEndFund
+	Preferred
+	Warrant
+	Right
+	Unit
+	ADR
+	REIT
+	Bond
 	InvalidAssetType
 )
 
@@ -17,6 +26,24 @@ func (a AssetType) String() string {
 		return "Stock"
 	case ETF:
 		return "ETF"
+	case MutualFund:
+		return "Mutual Fund"
+	case ClosedEndFund:
+		return "Closed-End Fund"
+	case Preferred:
+		return "Preferred"
+	case Warrant:
+		return "Warrant"
+	case Right:
+		return "Right"
+	case Unit:
+		return "Unit"
+	case ADR:
+		return "ADR"
+	case REIT:
+		return "REIT"
+	case Bond:
+		return "Bond"
 	default:
 		panic("invalid value of AssetType")
 	}
@@ -30,12 +57,10 @@ func (a AssetType) isValid() bool {
 }
 
 func parseAssetType(s string) (AssetType, error) {
-	switch s {
-	case "Stock":
-		return Stock, nil
-	case "ETF":
-		return ETF, nil
-	default:
-		return UnknownAssetType, fmt.Errorf("unable to parse Asset Type from: %s", s)
+	for a := UnknownAssetType + 1; a < InvalidAssetType; a++ {
+		if a.String() == s {
+			return a, nil
+		}
 	}
+	return UnknownAssetType, fmt.Errorf("unable to parse Asset Type from: %s", s)
 }
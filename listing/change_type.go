@@ -0,0 +1,34 @@
+package listing
+
+// ChangeType describes how a symbol differs between two listings
+type ChangeType int
+
+const (
+	UnknownChangeType ChangeType = iota
+	Added
+	Removed
+	Renamed
+	ExchangeChanged
+	Delisting
+	Relisted
+	InvalidChangeType
+)
+
+func (c ChangeType) String() string {
+	switch c {
+	case Added:
+		return "Added"
+	case Removed:
+		return "Removed"
+	case Renamed:
+		return "Renamed"
+	case ExchangeChanged:
+		return "ExchangeChanged"
+	case Delisting:
+		return "Delisting"
+	case Relisted:
+		return "Relisted"
+	default:
+		panic("invalid value of ChangeType")
+	}
+}
@@ -1,16 +1,66 @@
 package listing
 
-import "time"
+import (
+	"slices"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
 
 // Metadata describes how the request was made
 type Metadata struct {
 	// Options describes the options used
 	Options *Options
+	// Report describes how the rows of the listing were processed
+	Report *Report
+}
+
+// RowIssue describes a row of the listing that could not be used
+type RowIssue struct {
+	// Line is the row number in the listing, excluding the header
+	Line int
+	// Record is the content of the row, which may be nil if the row could not be read
+	Record []string
+	// Err describes the problem with the row
+	Err error
+}
+
+// Error returns the description of the problem with the row
+func (r *RowIssue) Error() string {
+	return r.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (r *RowIssue) Unwrap() error {
+	return r.Err
+}
+
+// Report summarises the processing of the rows of the listing
+type Report struct {
+	// Rows is the number of rows read, excluding the header
+	Rows int
+	// Added is the number of rows added to the Tradeables
+	Added int
+	// Skipped is the number of rows that could not be used, each of which has an entry in Issues
+	Skipped int
+	// Filtered is the number of valid rows excluded by the state, exchange or type filters of the Options
+	Filtered int
+	// Duplicates is the number of rows for a symbol that had already been read, all of which are kept in the History
+	Duplicates int
+	// Issues describes each of the Skipped rows
+	Issues []*RowIssue
 }
 
 // Symbol is the type of the tradeable identifier
 type Symbol string
 
+// Parse validates and normalises the symbol, identifying any exchange suffix.
+// Share classes such as BC/PA are valid, but a few listed symbols, such as NXT(EXP20091224),
+// cannot be used in requests and return common.ErrInvalidSymbol.
+func (s Symbol) Parse() (common.Symbol, error) {
+	return common.ParseSymbol(string(s))
+}
+
 // ExchangeName is the type of an exchange's name
 type ExchangeName string
 
@@ -22,16 +72,166 @@ type Info struct {
 	Type     AssetType
 	IPO      time.Time
 	Delisted time.Time
+	// Pending is true if the symbol has been announced for IPO but is not yet listed
+	Pending bool
+	// Status is whether the symbol is active or delisted
+	Status Status
+}
+
+// ActiveOn returns true if the symbol was listed on the specified date,
+// i.e. it had its IPO on or before the date and had not been delisted by the date
+func (i *Info) ActiveOn(date time.Time) bool {
+	if i.Pending || i.IPO.After(date) {
+		return false
+	}
+	return i.Delisted.IsZero() || date.Before(i.Delisted)
 }
 
 // Data is the returned object from a call to GetData
 type Data struct {
 	// Meta describes the details of the data
 	Meta *Metadata
-	// Tradeables is the map of all accessible data items
+	// Tradeables is the map of all accessible data items, holding the most recent listing of each symbol
 	Tradeables map[Symbol]*Info
+	// History holds every listing of each symbol, ordered by IPO and delisting, since symbols can be reused.
+	// Symbols without an entry have only the listing in Tradeables.
+	History map[Symbol][]*Info
 }
 
 func (d *Data) isValid() bool {
 	return true
 }
+
+// Contains returns true if the symbol is one of the Tradeables
+func (d *Data) Contains(symbol Symbol) bool {
+	if d == nil {
+		return false
+	}
+	_, ok := d.Tradeables[symbol]
+	return ok
+}
+
+// listings returns every listing of the symbol, ordered by IPO and delisting
+func (d *Data) listings(symbol Symbol) []*Info {
+	if h, ok := d.History[symbol]; ok {
+		return h
+	}
+	if info, ok := d.Tradeables[symbol]; ok {
+		return []*Info{info}
+	}
+	return nil
+}
+
+// compareListings orders listings by IPO and then by delisting, with listings that are still active last
+func compareListings(a, b *Info) int {
+	if n := a.IPO.Compare(b.IPO); n != 0 {
+		return n
+	}
+	switch {
+	case a.Delisted.Equal(b.Delisted):
+		return 0
+	case a.Delisted.IsZero():
+		return 1
+	case b.Delisted.IsZero():
+		return -1
+	}
+	return a.Delisted.Compare(b.Delisted)
+}
+
+// add records the listing in the History, retaining the most recent listing of the symbol in the Tradeables.
+// Listings with the same IPO and delisting dates as one already held are ignored, so that merges are idempotent.
+func (d *Data) add(info *Info) {
+	h := d.History[info.Symbol]
+	i, found := slices.BinarySearchFunc(h, info, compareListings)
+	if found {
+		return
+	}
+	d.History[info.Symbol] = slices.Insert(h, i, info)
+	d.Tradeables[info.Symbol] = d.History[info.Symbol][len(d.History[info.Symbol])-1]
+}
+
+// Merge combines the Tradeables of the listings into a new Data, so that active and delisted
+// listings can be queried as a single universe.  Since symbols can be reused, every listing of
+// a symbol is kept in the History, with the most recently listed retained in the Tradeables.
+func Merge(listings ...*Data) *Data {
+	result := &Data{
+		Meta:       &Metadata{},
+		Tradeables: map[Symbol]*Info{},
+		History:    map[Symbol][]*Info{},
+	}
+	for _, l := range listings {
+		if l == nil {
+			continue
+		}
+		for symbol := range l.Tradeables {
+			for _, info := range l.listings(symbol) {
+				result.add(info)
+			}
+		}
+	}
+	return result
+}
+
+// ActiveOn returns the Tradeables that were listed on the specified date.
+// Where a symbol has been reused, the listing that was active on the date is returned.
+func (d *Data) ActiveOn(date time.Time) *Data {
+	result := &Data{
+		Meta:       d.Meta,
+		Tradeables: map[Symbol]*Info{},
+		History:    map[Symbol][]*Info{},
+	}
+	for symbol := range d.Tradeables {
+		for _, info := range d.listings(symbol) {
+			if info.ActiveOn(date) {
+				result.Tradeables[symbol] = info
+				result.History[symbol] = []*Info{info}
+			}
+		}
+	}
+	return result
+}
+
+// IPO is a single upcoming listing in the IPOCalendar
+type IPO struct {
+	// Info describes the listing, with IPO set to the expected date and Pending set to true.
+	// The AssetType is not provided by Alpha Vantage, so is UnknownAssetType.
+	Info *Info
+	// PriceRangeLow is the low end of the expected offer price range, undefined if not yet set
+	PriceRangeLow common.OptionalFloat64
+	// PriceRangeHigh is the high end of the expected offer price range, undefined if not yet set
+	PriceRangeHigh common.OptionalFloat64
+	// Currency is the currency of the offer price range
+	Currency string
+}
+
+// IPOCalendar is the returned object from a call to GetIPOCalendar
+type IPOCalendar struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// IPOs is the map of all upcoming listings
+	IPOs map[Symbol]*IPO
+}
+
+// MergeIPOs adds the upcoming listings in the IPOCalendar to the Tradeables as pending entries,
+// so that symbols can be registered before they appear in LISTING_STATUS.
+// Symbols that are already present are left unchanged.  The number of entries added is returned.
+func (d *Data) MergeIPOs(c *IPOCalendar) int {
+	if c == nil {
+		return 0
+	}
+	if d.Tradeables == nil {
+		d.Tradeables = map[Symbol]*Info{}
+	}
+
+	var added int
+	for symbol, ipo := range c.IPOs {
+		if _, ok := d.Tradeables[symbol]; ok {
+			continue
+		}
+		info := *ipo.Info
+		info.Pending = true
+		d.Tradeables[symbol] = &info
+		added++
+	}
+	return added
+}
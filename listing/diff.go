@@ -0,0 +1,86 @@
+package listing
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Change describes a difference in a symbol between two listings
+type Change struct {
+	// Type describes the difference
+	Type ChangeType
+	// Symbol is the symbol that changed
+	Symbol Symbol
+	// Before is the entry in the earlier listing, which is nil if the symbol was Added
+	Before *Info
+	// After is the entry in the later listing, which is nil if the symbol was Removed
+	After *Info
+}
+
+// Changes is the result of Diff
+type Changes []*Change
+
+// Of returns the changes of the specified type
+func (c Changes) Of(t ChangeType) Changes {
+	result := Changes{}
+	for _, change := range c {
+		if change.Type == t {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+// Diff compares the Tradeables of two listings, returning the symbols that were added, removed,
+// renamed, moved exchange, delisted or relisted between the before and after listings, ordered by symbol.
+// A symbol with more than one difference, e.g. both renamed and moved exchange, results in a change for each.
+// A symbol that remains in a listing that includes delisted entries, but whose status or delisting date
+// changes, results in a Delisting change, or a Relisted change if it is no longer delisted.
+func Diff(before, after *Data) Changes {
+
+	var b, a map[Symbol]*Info
+	if before != nil {
+		b = before.Tradeables
+	}
+	if after != nil {
+		a = after.Tradeables
+	}
+
+	result := Changes{}
+
+	for symbol, prev := range b {
+		next, ok := a[symbol]
+		if !ok {
+			result = append(result, &Change{Type: Removed, Symbol: symbol, Before: prev})
+			continue
+		}
+		if prev.Name != next.Name {
+			result = append(result, &Change{Type: Renamed, Symbol: symbol, Before: prev, After: next})
+		}
+		if prev.Exchange != next.Exchange {
+			result = append(result, &Change{Type: ExchangeChanged, Symbol: symbol, Before: prev, After: next})
+		}
+		if prev.Status != next.Status || !prev.Delisted.Equal(next.Delisted) {
+			t := Delisting
+			if next.Status != Delisted && next.Delisted.IsZero() {
+				t = Relisted
+			}
+			result = append(result, &Change{Type: t, Symbol: symbol, Before: prev, After: next})
+		}
+	}
+
+	for symbol, next := range a {
+		if _, ok := b[symbol]; !ok {
+			result = append(result, &Change{Type: Added, Symbol: symbol, After: next})
+		}
+	}
+
+	slices.SortFunc(result, func(x, y *Change) int {
+		if n := cmp.Compare(x.Symbol, y.Symbol); n != 0 {
+			return n
+		}
+		return cmp.Compare(x.Type, y.Type)
+	})
+
+	return result
+}
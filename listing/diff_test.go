@@ -0,0 +1,110 @@
+package listing
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+
+	ipo := time.Date(2001, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	before := &Data{
+		Tradeables: map[Symbol]*Info{
+			"AAA": {Symbol: "AAA", Name: "Alpha Inc", Exchange: "NYSE", Type: Stock, IPO: ipo, Status: Active},
+			"BBB": {Symbol: "BBB", Name: "Beta Corp", Exchange: "NASDAQ", Type: Stock, IPO: ipo, Status: Active},
+			"CCC": {Symbol: "CCC", Name: "Gamma Ltd", Exchange: "NYSE", Type: Stock, IPO: ipo, Status: Active},
+			"DDD": {Symbol: "DDD", Name: "Delta Fund", Exchange: "NYSE ARCA", Type: ETF, IPO: ipo, Status: Active},
+		},
+	}
+
+	after := &Data{
+		Tradeables: map[Symbol]*Info{
+			"AAA": {Symbol: "AAA", Name: "Alpha Inc", Exchange: "NYSE", Type: Stock, IPO: ipo, Status: Active},
+			"BBB": {Symbol: "BBB", Name: "Beta Holdings Corp", Exchange: "NYSE", Type: Stock, IPO: ipo, Status: Active},
+			"DDD": {Symbol: "DDD", Name: "Delta Fund", Exchange: "BATS", Type: ETF, IPO: ipo, Status: Active},
+			"EEE": {Symbol: "EEE", Name: "Epsilon Co", Exchange: "NASDAQ", Type: Stock, IPO: ipo, Status: Active},
+		},
+	}
+
+	changes := Diff(before, after)
+
+	expected := []struct {
+		symbol Symbol
+		t      ChangeType
+	}{
+		{"BBB", Renamed},
+		{"BBB", ExchangeChanged},
+		{"CCC", Removed},
+		{"DDD", ExchangeChanged},
+		{"EEE", Added},
+	}
+
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %d", len(expected), len(changes))
+	}
+
+	for i, e := range expected {
+		if changes[i].Symbol != e.symbol || changes[i].Type != e.t {
+			t.Fatalf("change %d: expected %s %s, got %s %s", i, e.symbol, e.t, changes[i].Symbol, changes[i].Type)
+		}
+	}
+
+	if changes[2].After != nil || changes[2].Before.Name != "Gamma Ltd" {
+		t.Fatalf("unexpected removal: %+v", changes[2])
+	}
+
+	if added := changes.Of(Added); len(added) != 1 || added[0].Before != nil || added[0].After.Name != "Epsilon Co" {
+		t.Fatalf("unexpected additions: %+v", added)
+	}
+
+	if len(Diff(before, before)) != 0 {
+		t.Fatal("expected no changes between identical listings")
+	}
+
+	if len(Diff(nil, after)) != len(after.Tradeables) {
+		t.Fatal("expected every symbol to be added to an empty listing")
+	}
+}
+
+func TestDiff_Delisted(t *testing.T) {
+
+	before, err := ReadSnapshot(strings.NewReader(`symbol,name,exchange,assetType,ipoDate,delistingDate,status
+AAA,Alpha Inc,NYSE,Stock,2001-01-02,null,Active
+BBB,Beta Corp,NASDAQ,Stock,2001-01-02,null,Active
+CCC,Gamma Ltd,NYSE,Stock,2001-01-02,2010-05-03,Delisted
+DDD,Delta Fund,NYSE ARCA,ETF,2001-01-02,2012-07-02,Delisted
+`))
+	if err != nil {
+		t.Fatalf("failed to read snapshot: %v", err)
+	}
+
+	after, err := ReadSnapshot(strings.NewReader(`symbol,name,exchange,assetType,ipoDate,delistingDate,status
+AAA,Alpha Inc,NYSE,Stock,2001-01-02,null,Active
+BBB,Beta Corp,NASDAQ,Stock,2001-01-02,2011-03-01,Delisted
+CCC,Gamma Ltd,NYSE,Stock,2001-01-02,2010-05-03,Delisted
+DDD,Delta Fund,NYSE ARCA,ETF,2001-01-02,null,Active
+`))
+	if err != nil {
+		t.Fatalf("failed to read snapshot: %v", err)
+	}
+
+	changes := Diff(before, after)
+
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d", len(changes))
+	}
+
+	if changes[0].Symbol != "BBB" || changes[0].Type != Delisting || changes[0].After.Delisted != time.Date(2011, 3, 1, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected delisting: %+v", changes[0])
+	}
+
+	if changes[1].Symbol != "DDD" || changes[1].Type != Relisted || changes[1].Before.Status != Delisted {
+		t.Fatalf("unexpected relisting: %+v", changes[1])
+	}
+
+	if len(changes.Of(Delisting)) != 1 {
+		t.Fatalf("unexpected delistings: %+v", changes.Of(Delisting))
+	}
+}
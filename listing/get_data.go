@@ -2,16 +2,26 @@ package listing
 
 import (
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/gford1000-go/alphav/common"
 )
 
+// GetActiveListing returns the symbols that are actively traded
 func GetActiveListing(apiKey string, opts ...func(*Options) error) (*Data, error) {
+	return GetListing(apiKey, slices.Concat(opts, []func(*Options) error{WithState(Active)})...)
+}
+
+// GetListing returns the active or delisted symbols, either as of the latest trading day or
+// as of a historic date, depending upon the Options
+func GetListing(apiKey string, opts ...func(*Options) error) (*Data, error) {
 
 	var o = defaultOptions
 	for _, opt := range opts {
@@ -20,120 +30,260 @@ func GetActiveListing(apiKey string, opts ...func(*Options) error) (*Data, error
 		}
 	}
 
-	url := fmt.Sprintf("https://www.alphavantage.co/query?function=LISTING_STATUS&apikey=%s", apiKey)
+	body, err := getListingBody(apiKey, &o)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return parseListingCsv(body, &o)
+}
+
+// StreamListing returns an iterator over the active or delisted symbols, which are parsed
+// as they are read from the response rather than being collected into a Data.
+// The response is closed when the iteration completes or is stopped early.
+// Errors are yielded with a nil Info; in lenient mode iteration continues after a *RowIssue.
+// Unlike GetListing, entries for reused symbols are not de-duplicated.
+func StreamListing(apiKey string, opts ...func(*Options) error) iter.Seq2[*Info, error] {
+	return func(yield func(*Info, error) bool) {
+
+		var o = defaultOptions
+		for _, opt := range opts {
+			if err := opt(&o); err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+
+		body, err := getListingBody(apiKey, &o)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer body.Close()
+
+		for info, err := range parseListingRows(body, &o, nil) {
+			if !yield(info, err) {
+				return
+			}
+		}
+	}
+}
+
+// Stream returns an iterator over a listing in the LISTING_STATUS format, such as a snapshot,
+// applying the same filters and error handling as StreamListing
+func Stream(r io.Reader, opts ...func(*Options) error) iter.Seq2[*Info, error] {
+	return func(yield func(*Info, error) bool) {
+
+		var o = defaultOptions
+		for _, opt := range opts {
+			if err := opt(&o); err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+
+		for info, err := range parseListingRows(r, &o, nil) {
+			if !yield(info, err) {
+				return
+			}
+		}
+	}
+}
+
+// getListingBody makes the LISTING_STATUS request, returning the response body which must be closed by the caller
+func getListingBody(apiKey string, o *Options) (io.ReadCloser, error) {
+
+	q := common.NewQuery("LISTING_STATUS").Set("state", o.State.toAVString())
+	if !o.Date.IsZero() {
+		q.SetDate("date", o.Date)
+	}
+
+	url, err := q.URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := http.Get(url)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
 		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
 	}
 
-	return parseListingCsv(resp.Body, &o)
+	return resp.Body, nil
 }
 
 func parseListingCsv(data io.Reader, o *Options) (*Data, error) {
 
-	reader := csv.NewReader(data)
-	reader.TrimLeadingSpace = true
-
-	header, err := reader.Read()
-	if err != nil {
-		return nil, fmt.Errorf("error reading header: %w: %w", err, common.ErrRemoteCallError)
+	var result = &Data{
+		Tradeables: map[Symbol]*Info{},
+		History:    map[Symbol][]*Info{},
 	}
-
-	colIndex := map[string]int{}
-	for i, col := range header {
-		colIndex[strings.ToLower(col)] = i
+	var report = &Report{
+		Issues: []*RowIssue{},
 	}
 
-	var tradeables = map[Symbol]*Info{}
-
-	var line = 0
-	for {
-		line++
-		record, err := reader.Read()
-		if err == io.EOF {
-			break // Done
-		}
+	for info, err := range parseListingRows(data, o, report) {
 		if err != nil {
+			var issue *RowIssue
+			if o.Lenient && errors.As(err, &issue) {
+				continue // Already recorded in the report
+			}
 			return nil, err
 		}
 
-		if len(record) != len(header) {
-			continue
+		// Symbols can be reused, so every listing is retained in the History
+		if _, ok := result.Tradeables[info.Symbol]; ok {
+			report.Duplicates++
 		}
 
-		if record[colIndex["status"]] != "Active" {
-			continue // Must be active
+		result.add(info)
+	}
+
+	report.Added = len(result.Tradeables)
+
+	result.Meta = &Metadata{
+		Options: o,
+		Report:  report,
+	}
+	return result, nil
+}
+
+// parseListingRows yields each row of the listing that satisfies the filters of the Options.
+// Rows that cannot be used are yielded as a *RowIssue, after which iteration only continues in lenient mode,
+// except for rows with the wrong number of columns, which are always skipped and only yielded in lenient mode.
+// Errors reading the underlying data are not row issues, and always end the iteration.
+// The report, if provided, is updated as rows are processed.
+func parseListingRows(data io.Reader, o *Options, report *Report) iter.Seq2[*Info, error] {
+	return func(yield func(*Info, error) bool) {
+
+		if report == nil {
+			report = &Report{}
 		}
 
-		ipo, err := common.ParseDate(record[colIndex["ipodate"]])
+		reader := csv.NewReader(data)
+		reader.TrimLeadingSpace = true
+		reader.FieldsPerRecord = -1 // Column counts are checked per row, so that they can be reported
+
+		header, err := reader.Read()
 		if err != nil {
-			return nil, fmt.Errorf("line: %d: ipoDate error parsing '%s': %v", line, record[colIndex["ipodate"]], err)
+			yield(nil, fmt.Errorf("error reading header: %w: %w", err, common.ErrRemoteCallError))
+			return
 		}
 
-		var delist time.Time
-		delisted := record[colIndex["delistingdate"]]
-		if delisted != "null" {
-			delist, err = common.ParseDate(delisted)
-			if err != nil {
-				return nil, fmt.Errorf("line: %d: delistingDate error parsing '%s': %v", line, record[colIndex["delistingdate"]], err)
-			}
+		colIndex := map[string]int{}
+		for i, col := range header {
+			colIndex[strings.ToLower(col)] = i
 		}
 
-		assetType, err := parseAssetType(record[colIndex["assettype"]])
-		if err != nil {
-			return nil, fmt.Errorf("line: %d: assetType error parsing '%s': %v", line, record[colIndex["assettype"]], err)
+		for _, col := range []string{"symbol", "name", "exchange", "assettype", "ipodate", "delistingdate", "status"} {
+			if _, ok := colIndex[col]; !ok {
+				yield(nil, fmt.Errorf("missing column '%s' in header: %w", col, common.ErrParseError))
+				return
+			}
 		}
 
-		var canAdd bool = true
-		var exchange ExchangeName = ExchangeName(strings.ToUpper(record[colIndex["exchange"]]))
-		if len(o.ExchangeFilter) > 0 {
-			canAdd = false
-			for _, name := range o.ExchangeFilter {
-				if name == exchange {
-					canAdd = true
-					break
+		var line = 0
+		for {
+			line++
+			record, err := reader.Read()
+			if err == io.EOF {
+				return // Done
+			}
+
+			// Only format errors are specific to the row; any other error means the listing cannot be read further
+			var parseErr *csv.ParseError
+			if err != nil && !errors.As(err, &parseErr) {
+				yield(nil, fmt.Errorf("line: %d: error reading listing: %w: %w", line, err, common.ErrRemoteCallError))
+				return
+			}
+			report.Rows++
+
+			if err == nil && len(record) != len(header) {
+				// Rows with the wrong number of columns are skipped rather than failing a strict listing
+				issue := &RowIssue{
+					Line:   line,
+					Record: record,
+					Err:    fmt.Errorf("line: %d: expected %d columns, got %d", line, len(header), len(record)),
+				}
+				report.Skipped++
+				report.Issues = append(report.Issues, issue)
+				if o.Lenient && !yield(nil, issue) {
+					return
 				}
+				continue
 			}
-		}
 
-		if !canAdd {
-			continue // Filtered out by exchange
-		}
+			// Filters are applied before the row is parsed, so that rows which would be excluded cannot fail the listing
+			if err == nil && !o.includes(record[colIndex["status"]], record[colIndex["exchange"]], record[colIndex["assettype"]]) {
+				report.Filtered++
+				continue
+			}
 
-		if len(o.TypeFilter) > 0 {
-			canAdd = false
-			for _, t := range o.TypeFilter {
-				if t == assetType {
-					canAdd = true
-					break
+			var info *Info
+			if err == nil {
+				info, err = parseListingRecord(record, colIndex, line)
+			}
+
+			if err != nil {
+				issue := &RowIssue{
+					Line:   line,
+					Record: record,
+					Err:    err,
 				}
+				report.Skipped++
+				report.Issues = append(report.Issues, issue)
+				if !yield(nil, issue) || !o.Lenient {
+					return
+				}
+				continue
 			}
-		}
 
-		if canAdd {
-			info := &Info{
-				Symbol:   Symbol(record[colIndex["symbol"]]),
-				Name:     record[colIndex["name"]],
-				Type:     assetType,
-				Exchange: exchange,
-				IPO:      ipo,
-				Delisted: delist,
+			if !yield(info, nil) {
+				return
 			}
+		}
+	}
+}
 
-			tradeables[info.Symbol] = info
+// parseListingRecord creates the Info described by the row of the listing
+func parseListingRecord(record []string, colIndex map[string]int, line int) (*Info, error) {
+
+	status, err := parseStatus(record[colIndex["status"]])
+	if err != nil {
+		return nil, fmt.Errorf("line: %d: status error parsing '%s': %v", line, record[colIndex["status"]], err)
+	}
+
+	ipo, err := common.ParseDate(record[colIndex["ipodate"]])
+	if err != nil {
+		return nil, fmt.Errorf("line: %d: ipoDate error parsing '%s': %v", line, record[colIndex["ipodate"]], err)
+	}
+
+	var delist time.Time
+	delisted := record[colIndex["delistingdate"]]
+	if delisted != "null" {
+		delist, err = common.ParseDate(delisted)
+		if err != nil {
+			return nil, fmt.Errorf("line: %d: delistingDate error parsing '%s': %v", line, record[colIndex["delistingdate"]], err)
 		}
 	}
 
-	return &Data{
-		Meta: &Metadata{
-			Options: o,
-		},
-		Tradeables: tradeables,
+	assetType, err := parseAssetType(record[colIndex["assettype"]])
+	if err != nil {
+		return nil, fmt.Errorf("line: %d: assetType error parsing '%s': %v", line, record[colIndex["assettype"]], err)
+	}
+
+	return &Info{
+		Symbol:   Symbol(record[colIndex["symbol"]]),
+		Name:     record[colIndex["name"]],
+		Type:     assetType,
+		Exchange: ExchangeName(strings.ToUpper(record[colIndex["exchange"]])),
+		IPO:      ipo,
+		Delisted: delist,
+		Status:   status,
 	}, nil
 }
@@ -2,8 +2,14 @@ package listing
 
 import (
 	"bytes"
+	"errors"
+	"io"
 	"os"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
 )
 
 func TestGetData(t *testing.T) {
@@ -100,3 +106,253 @@ func TestGetData_2(t *testing.T) {
 		}
 	}
 }
+
+func TestGetData_3(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/delisted_listing_status.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var o = defaultOptions
+	if err := WithState(Delisted)(&o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := parseListingCsv(bytes.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	if len(result.Tradeables) != 5 {
+		t.Fatalf("expected 5 delisted symbols, got %d", len(result.Tradeables))
+	}
+
+	aaba := result.Tradeables["AABA"]
+	if aaba.Status != Delisted || aaba.Delisted != time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected AABA entry: %v", aaba)
+	}
+
+	// The most recent use of a reused symbol is in the Tradeables, with every use in the History
+	if result.Tradeables["ACQX"].Name != "Acquisition Corp X II" {
+		t.Fatalf("unexpected ACQX entry: %v", result.Tradeables["ACQX"])
+	}
+
+	if report := result.Meta.Report; report.Added != 5 || report.Duplicates != 1 || report.Filtered != 0 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	if h := result.History["ACQX"]; len(h) != 2 || h[0].Name != "Acquisition Corp X" || h[1] != result.Tradeables["ACQX"] {
+		t.Fatalf("unexpected ACQX history: %v", h)
+	}
+
+	// Active rows are excluded when delisted symbols are requested, and vice versa
+	o = defaultOptions
+	result, err = parseListingCsv(bytes.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	if len(result.Tradeables) != 0 {
+		t.Fatalf("expected no active symbols, got %d", len(result.Tradeables))
+	}
+}
+
+func TestActiveOn(t *testing.T) {
+
+	active, err := os.ReadFile("../example_data/listing_status.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	delisted, err := os.ReadFile("../example_data/delisted_listing_status.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var o = defaultOptions
+	a, err := parseListingCsv(bytes.NewReader(active), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	o.State = Delisted
+	d, err := parseListingCsv(bytes.NewReader(delisted), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	universe := Merge(a, d)
+
+	if len(universe.Tradeables) != len(a.Tradeables)+len(d.Tradeables)-1 {
+		t.Fatalf("unexpected universe size: %d", len(universe.Tradeables))
+	}
+
+	// The active IBM listing is more recent than the delisted entry
+	if universe.Tradeables["IBM"].Status != Active {
+		t.Fatalf("unexpected IBM entry: %v", universe.Tradeables["IBM"])
+	}
+
+	date := time.Date(2015, 1, 2, 0, 0, 0, 0, time.UTC)
+	pit := universe.ActiveOn(date)
+
+	for _, symbol := range []Symbol{"AABA", "XYZE", "IBM", "AAPL", "ACQX"} {
+		if !pit.Contains(symbol) {
+			t.Fatalf("expected %s to be active on %v", symbol, date)
+		}
+	}
+
+	// The first use of the reused symbol was active on the date, not the most recent
+	if pit.Tradeables["ACQX"].Name != "Acquisition Corp X" {
+		t.Fatalf("unexpected ACQX entry: %v", pit.Tradeables["ACQX"])
+	}
+
+	// Neither use of the reused symbol was active between its listings
+	if universe.ActiveOn(time.Date(2017, 1, 3, 0, 0, 0, 0, time.UTC)).Contains("ACQX") {
+		t.Fatal("expected ACQX to be inactive between its listings")
+	}
+
+	// Both IBM listings are retained
+	if len(universe.History["IBM"]) != 2 || !pit.Contains("IBM") || pit.Tradeables["IBM"].Status != Active {
+		t.Fatalf("unexpected IBM history: %v", universe.History["IBM"])
+	}
+
+	for _, symbol := range []Symbol{"AAAB"} {
+		if pit.Contains(symbol) {
+			t.Fatalf("expected %s to be inactive on %v", symbol, date)
+		}
+	}
+
+	if universe.Tradeables["AABA"].ActiveOn(time.Date(2019, 10, 7, 0, 0, 0, 0, time.UTC)) {
+		t.Fatal("expected AABA to be inactive on its delisting date")
+	}
+}
+
+func TestGetData_4(t *testing.T) {
+
+	data := `symbol,name,exchange,assetType,ipoDate,delistingDate,status
+AAA,Good Stock,NYSE,Stock,2001-01-02,null,Active
+BBB,Good Fund,NASDAQ,Mutual Fund,2002-03-04,null,Active
+CCC,Odd Type,NYSE,Widget,2003-05-06,null,Active
+DDD,Bad Date,NYSE,Stock,not-a-date,null,Active
+EEE,Short Row,NYSE
+FFF,Old Stock,NYSE,Stock,1990-01-02,2000-01-03,Delisted
+GGG,Good Warrant,NYSE,Warrant,2020-07-08,null,Active
+`
+
+	var o = defaultOptions
+
+	// Strict mode fails on the first invalid row
+	if _, err := parseListingCsv(strings.NewReader(data), &o); err == nil {
+		t.Fatal("expected error in strict mode, got nil")
+	}
+
+	o.Lenient = true
+	o.ExchangeFilter = []ExchangeName{"NYSE", "NASDAQ"}
+	o.TypeFilter = []AssetType{Stock, MutualFund}
+
+	result, err := parseListingCsv(strings.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	// The unknown type of CCC is filtered out rather than being an issue
+	report := result.Meta.Report
+	if report.Rows != 7 || report.Added != 2 || report.Skipped != 2 || report.Filtered != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	if len(report.Issues) != 2 || report.Issues[0].Line != 4 || report.Issues[1].Record[0] != "EEE" {
+		t.Fatalf("unexpected issues: %+v", report.Issues)
+	}
+
+	if result.Tradeables["BBB"].Type != MutualFund {
+		t.Fatalf("unexpected BBB entry: %v", result.Tradeables["BBB"])
+	}
+}
+
+func TestGetData_6(t *testing.T) {
+
+	data := `symbol,name,exchange,assetType,ipoDate,delistingDate,status
+AAA,Good Stock,NYSE,Stock,2001-01-02,null,Active
+EEE,Short Row,NYSE
+FFF,Old Stock,NYSE,Stock,1990-01-02,not-a-date,Delisted
+GGG,Odd Type,NYSE,Widget,2003-05-06,null,Active
+`
+
+	var o = defaultOptions
+	o.TypeFilter = []AssetType{Stock}
+
+	// Strict mode skips rows with the wrong number of columns, and does not parse rows excluded by the filters
+	result, err := parseListingCsv(strings.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	report := result.Meta.Report
+	if report.Rows != 4 || report.Added != 1 || report.Skipped != 1 || report.Filtered != 2 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	if len(report.Issues) != 1 || report.Issues[0].Line != 2 {
+		t.Fatalf("unexpected issues: %+v", report.Issues)
+	}
+
+	// Unfiltered rows that cannot be parsed still fail in strict mode
+	o.State = UnknownStatus
+	if _, err := parseListingCsv(strings.NewReader(data), &o); err == nil {
+		t.Fatal("expected error in strict mode, got nil")
+	}
+}
+
+// failingReader returns an error once its data is exhausted, like a dropped connection
+type failingReader struct {
+	r     io.Reader
+	reads int
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	f.reads++
+	n, err := f.r.Read(p)
+	if err == io.EOF {
+		return n, errors.New("connection reset")
+	}
+	return n, err
+}
+
+func TestGetData_5(t *testing.T) {
+
+	r := &failingReader{
+		r: strings.NewReader(`symbol,name,exchange,assetType,ipoDate,delistingDate,status
+AAA,Good Stock,NYSE,Stock,2001-01-02,null,Active
+`),
+	}
+
+	var o = defaultOptions
+	o.Lenient = true
+
+	// Read errors are not row issues, so end the listing even in lenient mode
+	_, err := parseListingCsv(r, &o)
+	if !errors.Is(err, common.ErrRemoteCallError) {
+		t.Fatalf("expected remote call error, got %v", err)
+	}
+
+	var issue *RowIssue
+	if errors.As(err, &issue) {
+		t.Fatalf("unexpected row issue: %v", issue)
+	}
+
+	if r.reads > 10 {
+		t.Fatalf("unexpected number of reads after error: %d", r.reads)
+	}
+}
+
+func TestAssetType(t *testing.T) {
+
+	for a := UnknownAssetType + 1; a < InvalidAssetType; a++ {
+		p, err := parseAssetType(a.String())
+		if err != nil || p != a {
+			t.Fatalf("failed to round trip %s: %v", a, err)
+		}
+	}
+}
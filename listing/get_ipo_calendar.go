@@ -0,0 +1,135 @@
+package listing
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// GetIPOCalendar returns the listings expected to IPO in the next three months.
+// Only the exchange filter of the Options is applied, since IPO_CALENDAR does not provide an asset type.
+func GetIPOCalendar(apiKey string, opts ...func(*Options) error) (*IPOCalendar, error) {
+
+	var o = defaultOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	url, err := common.NewQuery("IPO_CALENDAR").URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	return parseIPOCalendarCsv(resp.Body, &o)
+}
+
+func parseIPOCalendarCsv(data io.Reader, o *Options) (*IPOCalendar, error) {
+
+	reader := csv.NewReader(data)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error reading header: %w: %w", err, common.ErrRemoteCallError)
+	}
+
+	colIndex := map[string]int{}
+	for i, col := range header {
+		colIndex[strings.ToLower(col)] = i
+	}
+
+	for _, col := range []string{"symbol", "name", "ipodate", "pricerangelow", "pricerangehigh", "currency", "exchange"} {
+		if _, ok := colIndex[col]; !ok {
+			return nil, fmt.Errorf("missing column '%s' in header: %w", col, common.ErrParseError)
+		}
+	}
+
+	var ipos = map[Symbol]*IPO{}
+
+	var line = 0
+	for {
+		line++
+		record, err := reader.Read()
+		if err == io.EOF {
+			break // Done
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(record) != len(header) {
+			continue
+		}
+
+		ipoDate, err := common.ParseDate(record[colIndex["ipodate"]])
+		if err != nil {
+			return nil, fmt.Errorf("line: %d: ipoDate error parsing '%s': %v", line, record[colIndex["ipodate"]], err)
+		}
+
+		low, err := parsePrice(record[colIndex["pricerangelow"]])
+		if err != nil {
+			return nil, fmt.Errorf("line: %d: priceRangeLow error parsing '%s': %v", line, record[colIndex["pricerangelow"]], err)
+		}
+
+		high, err := parsePrice(record[colIndex["pricerangehigh"]])
+		if err != nil {
+			return nil, fmt.Errorf("line: %d: priceRangeHigh error parsing '%s': %v", line, record[colIndex["pricerangehigh"]], err)
+		}
+
+		var exchange ExchangeName = ExchangeName(strings.ToUpper(record[colIndex["exchange"]]))
+		if len(o.ExchangeFilter) > 0 && !slices.Contains(o.ExchangeFilter, exchange) {
+			continue // Filtered out by exchange
+		}
+
+		ipo := &IPO{
+			Info: &Info{
+				Symbol:   Symbol(record[colIndex["symbol"]]),
+				Name:     record[colIndex["name"]],
+				Exchange: exchange,
+				IPO:      ipoDate,
+				Pending:  true,
+			},
+			PriceRangeLow:  low,
+			PriceRangeHigh: high,
+			Currency:       record[colIndex["currency"]],
+		}
+
+		ipos[ipo.Info.Symbol] = ipo
+	}
+
+	return &IPOCalendar{
+		Meta: &Metadata{
+			Options: o,
+		},
+		IPOs: ipos,
+	}, nil
+}
+
+// parsePrice treats a zero price as undefined, which is how IPO_CALENDAR reports a range that is not yet set
+func parsePrice(s string) (common.OptionalFloat64, error) {
+	v, err := common.ParseOptionalFloat64(s)
+	if err != nil {
+		return v, err
+	}
+	if v.Defined && v.Value == 0 {
+		return common.OptionalFloat64{}, nil
+	}
+	return v, nil
+}
@@ -0,0 +1,98 @@
+package listing
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestParseIPOCalendarCsv(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ipo_calendar.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var o = defaultOptions
+
+	result, err := parseIPOCalendarCsv(bytes.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	if len(result.IPOs) != 4 {
+		t.Fatalf("expected 4 IPOs, got %d", len(result.IPOs))
+	}
+
+	ipo, ok := result.IPOs["NEWCO"]
+	if !ok {
+		t.Fatal("expected NEWCO in IPOs")
+	}
+	if !ipo.Info.Pending {
+		t.Fatal("expected NEWCO to be pending")
+	}
+	if ipo.PriceRangeLow.Value != 14 || ipo.PriceRangeHigh.Value != 16 {
+		t.Fatalf("unexpected price range: %v - %v", ipo.PriceRangeLow, ipo.PriceRangeHigh)
+	}
+
+	if !result.IPOs["QQZZ"].PriceRangeLow.IsUndefined() {
+		t.Fatalf("expected undefined price range for QQZZ, got %v", result.IPOs["QQZZ"].PriceRangeLow)
+	}
+}
+
+func TestParseIPOCalendarCsv_1(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ipo_calendar.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var o = defaultOptions
+	o.ExchangeFilter = []ExchangeName{"NYSE"}
+
+	result, err := parseIPOCalendarCsv(bytes.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	if len(result.IPOs) != 2 {
+		t.Fatalf("expected 2 IPOs, got %d", len(result.IPOs))
+	}
+}
+
+func TestMergeIPOs(t *testing.T) {
+
+	listingData, _ := os.ReadFile("../example_data/listing_status.csv")
+	ipoData, _ := os.ReadFile("../example_data/ipo_calendar.csv")
+
+	var o = defaultOptions
+
+	result, err := parseListingCsv(bytes.NewReader(listingData), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	calendar, err := parseIPOCalendarCsv(bytes.NewReader(ipoData), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	n := len(result.Tradeables)
+
+	added := result.MergeIPOs(calendar)
+	if added != 3 {
+		t.Fatalf("expected 3 IPOs to be added, got %d", added)
+	}
+
+	if len(result.Tradeables) != n+added {
+		t.Fatalf("expected %d tradeables, got %d", n+added, len(result.Tradeables))
+	}
+
+	if !result.Tradeables["NEWCO"].Pending {
+		t.Fatal("expected NEWCO to be pending")
+	}
+
+	if result.Tradeables["A"].Pending {
+		t.Fatal("expected existing listing for A to be unchanged")
+	}
+}
@@ -0,0 +1,102 @@
+package listing
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// NameIndex provides fast lookup of the Tradeables of a listing by name.
+// Names are compared ignoring case and repeated whitespace.
+// The index is not updated if the listing changes after it is created.
+type NameIndex struct {
+	// names is ordered by normalised name, to support prefix searches
+	names []*indexedName
+	// words maps each normalised word of a name to the entries containing it
+	words map[string][]*Info
+}
+
+type indexedName struct {
+	name string
+	info *Info
+}
+
+// normaliseName returns the form of a name used within the NameIndex
+func normaliseName(s string) string {
+	return strings.Join(strings.Fields(strings.ToUpper(s)), " ")
+}
+
+// NameIndex creates an index over the names of the Tradeables
+func (d *Data) NameIndex() *NameIndex {
+	idx := &NameIndex{
+		names: make([]*indexedName, 0, len(d.Tradeables)),
+		words: map[string][]*Info{},
+	}
+
+	for _, info := range d.Tradeables {
+		name := normaliseName(info.Name)
+		idx.names = append(idx.names, &indexedName{name: name, info: info})
+		seen := map[string]bool{}
+		for _, w := range strings.Fields(name) {
+			if !seen[w] {
+				seen[w] = true
+				idx.words[w] = append(idx.words[w], info)
+			}
+		}
+	}
+
+	slices.SortFunc(idx.names, func(a, b *indexedName) int {
+		if n := cmp.Compare(a.name, b.name); n != 0 {
+			return n
+		}
+		return cmp.Compare(a.info.Symbol, b.info.Symbol)
+	})
+	for _, infos := range idx.words {
+		sortBySymbol(infos)
+	}
+
+	return idx
+}
+
+func sortBySymbol(infos []*Info) {
+	slices.SortFunc(infos, func(a, b *Info) int { return cmp.Compare(a.Symbol, b.Symbol) })
+}
+
+// Lookup returns the entries with the name, ordered by symbol
+func (idx *NameIndex) Lookup(name string) []*Info {
+	name = normaliseName(name)
+	result := []*Info{}
+	for _, n := range idx.withPrefix(name) {
+		if n.name == name {
+			result = append(result, n.info)
+		}
+	}
+	return result
+}
+
+// WithPrefix returns the entries whose name starts with the prefix, ordered by name
+func (idx *NameIndex) WithPrefix(prefix string) []*Info {
+	names := idx.withPrefix(normaliseName(prefix))
+	result := make([]*Info, 0, len(names))
+	for _, n := range names {
+		result = append(result, n.info)
+	}
+	return result
+}
+
+// withPrefix returns the range of names that start with the normalised prefix
+func (idx *NameIndex) withPrefix(prefix string) []*indexedName {
+	start, _ := slices.BinarySearchFunc(idx.names, prefix, func(e *indexedName, t string) int {
+		return cmp.Compare(e.name, t)
+	})
+	end := start
+	for end < len(idx.names) && strings.HasPrefix(idx.names[end].name, prefix) {
+		end++
+	}
+	return idx.names[start:end]
+}
+
+// WithWord returns the entries whose name contains the whole word, ordered by symbol
+func (idx *NameIndex) WithWord(word string) []*Info {
+	return slices.Clone(idx.words[normaliseName(word)])
+}
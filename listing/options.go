@@ -2,7 +2,10 @@ package listing
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
+	"time"
 )
 
 // Options can change the returned Data from GetData
@@ -11,9 +14,41 @@ type Options struct {
 	TypeFilter []AssetType
 	// ExchangeName limits to only the specified Exchanges.  Default is all Exchanges
 	ExchangeFilter []ExchangeName
+	// State selects whether active or delisted symbols are returned.  Default is Active
+	State Status
+	// Date, if set, returns the listing as of that date.  Default is the latest trading day
+	Date time.Time
+	// Lenient = true skips rows that cannot be parsed, recording them in the Report of the Metadata,
+	// rather than failing the whole listing.  Rows with the wrong number of columns are always skipped
+	// and rows excluded by the filters are never parsed.  Default is false
+	Lenient bool
 }
 
-var defaultOptions = Options{}
+// includes returns true if the raw status, exchange and asset type of a row satisfy the state, exchange
+// and type filters.  Values that cannot be parsed do not satisfy a filter that has been set.
+func (o *Options) includes(status, exchange, assetType string) bool {
+	if o.State != UnknownStatus {
+		if s, err := parseStatus(status); err != nil || s != o.State {
+			return false
+		}
+	}
+	if len(o.ExchangeFilter) > 0 && !slices.Contains(o.ExchangeFilter, ExchangeName(strings.ToUpper(exchange))) {
+		return false
+	}
+	if len(o.TypeFilter) > 0 {
+		if t, err := parseAssetType(assetType); err != nil || !slices.Contains(o.TypeFilter, t) {
+			return false
+		}
+	}
+	return true
+}
+
+var defaultOptions = Options{
+	State: Active,
+}
+
+// earliestListingDate is the first date for which a historic listing is available
+var earliestListingDate = time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
 
 // WithOnlyTypes limits the set of returned listings to be restricted to the specified AssetTypes
 // Not setting a type filter means all listings of any type are returned.
@@ -43,3 +78,34 @@ func WithOnlyNamedExchanges(exchanges []ExchangeName) func(*Options) error {
 		return nil
 	}
 }
+
+// WithState selects whether active or delisted symbols are returned
+func WithState(state Status) func(*Options) error {
+	return func(o *Options) error {
+		if !state.isValid() {
+			return errors.New("invalid listing state")
+		}
+		o.State = state
+		return nil
+	}
+}
+
+// WithDate returns the listing as of the specified date, which must be after 2010-01-01.
+// This allows survivorship bias free universes to be constructed.
+func WithDate(date time.Time) func(*Options) error {
+	return func(o *Options) error {
+		if date.Before(earliestListingDate) || date.After(time.Now()) {
+			return fmt.Errorf("invalid date specified: %s", date.Format(time.DateOnly))
+		}
+		o.Date = date
+		return nil
+	}
+}
+
+// WithLenient determines whether rows that cannot be parsed are skipped and reported, or fail the listing
+func WithLenient(lenient bool) func(*Options) error {
+	return func(o *Options) error {
+		o.Lenient = lenient
+		return nil
+	}
+}
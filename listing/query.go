@@ -0,0 +1,259 @@
+package listing
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// SortKey determines the order of the results of a Query
+type SortKey int
+
+const (
+	UnknownSortKey SortKey = iota
+	BySymbol
+	ByName
+	ByIPO
+	ByExchange
+	InvalidSortKey
+)
+
+func (k SortKey) isValid() bool {
+	if k <= UnknownSortKey || k >= InvalidSortKey {
+		return false
+	}
+	return true
+}
+
+// Query selects Tradeables from a listing using a set of predicates, all of which must be satisfied.
+// Every listing of a reused symbol is checked, with the most recent listing that satisfies the predicates returned.
+// Queries are built by chaining calls, for example:
+//
+//	infos, err := data.Query().OnExchanges("NYSE").OfTypes(Stock).NameContains("bank").OrderBy(ByIPO, false).Results()
+//
+// Each call returns a new Query, leaving the receiver unchanged, so that a partial Query can be
+// extended in different ways.  Invalid arguments are reported by Results.
+type Query struct {
+	data       *Data
+	predicates []func(*Info) bool
+	sortKey    SortKey
+	descending bool
+	limit      int
+	err        error
+}
+
+// Query starts a new Query over the Tradeables, which by default returns all entries ordered by symbol
+func (d *Data) Query() *Query {
+	return &Query{
+		data:    d,
+		sortKey: BySymbol,
+	}
+}
+
+// Where adds a custom predicate to the Query
+func (q *Query) Where(f func(*Info) bool) *Query {
+	if f == nil {
+		return q.withErr(errors.New("nil predicate"))
+	}
+	q = q.clone()
+	q.predicates = append(q.predicates, f)
+	return q
+}
+
+// OnExchanges restricts the results to entries listed on any of the exchanges
+func (q *Query) OnExchanges(exchanges ...ExchangeName) *Query {
+	names := []ExchangeName{}
+	for _, e := range exchanges {
+		names = append(names, ExchangeName(strings.ToUpper(string(e))))
+	}
+	return q.Where(func(i *Info) bool { return slices.Contains(names, i.Exchange) })
+}
+
+// OfTypes restricts the results to entries of any of the asset types
+func (q *Query) OfTypes(types ...AssetType) *Query {
+	for _, t := range types {
+		if !t.isValid() {
+			return q.withErr(fmt.Errorf("invalid asset type: %d", t))
+		}
+	}
+	types = slices.Clone(types)
+	return q.Where(func(i *Info) bool { return slices.Contains(types, i.Type) })
+}
+
+// IPOBetween restricts the results to entries with an IPO between from and to inclusive.
+// A zero time leaves that end of the range open.
+func (q *Query) IPOBetween(from, to time.Time) *Query {
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return q.withErr(fmt.Errorf("invalid IPO range: %v to %v", from, to))
+	}
+	return q.Where(func(i *Info) bool {
+		return (from.IsZero() || !i.IPO.Before(from)) && (to.IsZero() || !i.IPO.After(to))
+	})
+}
+
+// NameContains restricts the results to entries whose name contains the string, ignoring case
+func (q *Query) NameContains(s string) *Query {
+	s = strings.ToUpper(s)
+	return q.Where(func(i *Info) bool { return strings.Contains(strings.ToUpper(i.Name), s) })
+}
+
+// NameMatches restricts the results to entries whose name matches the regular expression
+func (q *Query) NameMatches(expr string) *Query {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return q.withErr(err)
+	}
+	return q.Where(func(i *Info) bool { return re.MatchString(i.Name) })
+}
+
+// SymbolMatches restricts the results to entries whose symbol matches the glob pattern,
+// using the syntax of path.Match, e.g. "AA*" or "BRK?B"
+func (q *Query) SymbolMatches(pattern string) *Query {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return q.withErr(fmt.Errorf("invalid symbol pattern '%s': %w", pattern, err))
+	}
+	return q.Where(func(i *Info) bool {
+		ok, _ := path.Match(pattern, string(i.Symbol))
+		return ok
+	})
+}
+
+// ActiveOn restricts the results to entries that were listed on the date.  Where a symbol has been
+// reused, the listing that was active on the date is returned.
+func (q *Query) ActiveOn(date time.Time) *Query {
+	return q.Where(func(i *Info) bool { return i.ActiveOn(date) })
+}
+
+// OrderBy sets the order of the results.  Entries with the same key are ordered by symbol.
+func (q *Query) OrderBy(key SortKey, descending bool) *Query {
+	if !key.isValid() {
+		return q.withErr(fmt.Errorf("invalid sort key: %d", key))
+	}
+	q = q.clone()
+	q.sortKey = key
+	q.descending = descending
+	return q
+}
+
+// Limit restricts the number of results, with zero meaning no limit
+func (q *Query) Limit(n int) *Query {
+	if n < 0 {
+		return q.withErr(fmt.Errorf("invalid limit: %d", n))
+	}
+	q = q.clone()
+	q.limit = n
+	return q
+}
+
+// clone returns a copy of the Query, so that changes to the copy do not affect the original
+func (q *Query) clone() *Query {
+	c := *q
+	c.predicates = slices.Clone(q.predicates)
+	return &c
+}
+
+// withErr returns a copy of the Query with the error recorded, unless an earlier error has been recorded
+func (q *Query) withErr(err error) *Query {
+	q = q.clone()
+	if q.err == nil {
+		q.err = err
+	}
+	return q
+}
+
+func (q *Query) matches(i *Info) bool {
+	for _, p := range q.predicates {
+		if !p(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// matching returns the listings of the symbol that satisfy the Query, ordered by IPO and delisting
+func (q *Query) matching(symbol Symbol) []*Info {
+	result := []*Info{}
+	for _, i := range q.data.listings(symbol) {
+		if q.matches(i) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// Results returns the entries satisfying the Query, in the requested order
+func (q *Query) Results() ([]*Info, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+
+	result := []*Info{}
+	if q.data != nil {
+		for symbol := range q.data.Tradeables {
+			if m := q.matching(symbol); len(m) > 0 {
+				result = append(result, m[len(m)-1])
+			}
+		}
+	}
+
+	slices.SortFunc(result, func(a, b *Info) int {
+		var n int
+		switch q.sortKey {
+		case ByName:
+			n = cmp.Compare(a.Name, b.Name)
+		case ByIPO:
+			n = a.IPO.Compare(b.IPO)
+		case ByExchange:
+			n = cmp.Compare(a.Exchange, b.Exchange)
+		}
+		if n == 0 {
+			n = cmp.Compare(a.Symbol, b.Symbol)
+		}
+		if q.descending {
+			return -n
+		}
+		return n
+	})
+
+	if q.limit > 0 && len(result) > q.limit {
+		result = result[:q.limit]
+	}
+	return result, nil
+}
+
+// Data returns the entries satisfying the Query as a listing, which retains the Metadata of the original.
+// The History holds every listing of each symbol that satisfies the Query.  Any order or limit is ignored.
+func (q *Query) Data() (*Data, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+
+	result := &Data{
+		Tradeables: map[Symbol]*Info{},
+		History:    map[Symbol][]*Info{},
+	}
+	if q.data != nil {
+		result.Meta = q.data.Meta
+		for symbol := range q.data.Tradeables {
+			if m := q.matching(symbol); len(m) > 0 {
+				result.Tradeables[symbol] = m[len(m)-1]
+				result.History[symbol] = m
+			}
+		}
+	}
+	return result, nil
+}
+
+// Count returns the number of entries satisfying the Query
+func (q *Query) Count() (int, error) {
+	d, err := q.Data()
+	if err != nil {
+		return 0, err
+	}
+	return len(d.Tradeables), nil
+}
@@ -0,0 +1,221 @@
+package listing
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+)
+
+func loadListing(t *testing.T) *Data {
+	t.Helper()
+
+	data, err := os.ReadFile("../example_data/listing_status.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var o = defaultOptions
+
+	result, err := parseListingCsv(bytes.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+	return result
+}
+
+func TestQuery(t *testing.T) {
+
+	d := loadListing(t)
+
+	infos, err := d.Query().
+		OnExchanges("nasdaq").
+		OfTypes(Stock).
+		IPOBetween(time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)).
+		NameContains("apple").
+		Results()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(infos) != 1 || infos[0].Symbol != "AAPL" {
+		t.Fatalf("unexpected results: %v", infos)
+	}
+
+	infos, err = d.Query().SymbolMatches("AA*").OrderBy(ByIPO, true).Limit(3).Results()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(infos) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(infos))
+	}
+
+	for i, info := range infos {
+		if info.Symbol[:2] != "AA" {
+			t.Fatalf("unexpected symbol: %s", info.Symbol)
+		}
+		if i > 0 && info.IPO.After(infos[i-1].IPO) {
+			t.Fatalf("results not in descending IPO order: %v", infos)
+		}
+	}
+
+	n, err := d.Query().NameMatches(`^International Business Machines`).ActiveOn(time.Date(2000, 1, 3, 0, 0, 0, 0, time.UTC)).Count()
+	if err != nil || n != 1 {
+		t.Fatalf("unexpected count: %d (%v)", n, err)
+	}
+
+	sub, err := d.Query().OfTypes(ETF).Data()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, info := range sub.Tradeables {
+		if info.Type != ETF {
+			t.Fatalf("unexpected entry: %v", info)
+		}
+	}
+	if sub.Meta != d.Meta {
+		t.Fatal("expected metadata to be retained")
+	}
+}
+
+func TestQuery_1(t *testing.T) {
+
+	d := loadListing(t)
+
+	// Invalid arguments are reported by Results
+	if _, err := d.Query().NameMatches("(").Results(); err == nil {
+		t.Fatal("expected error for invalid regular expression, got nil")
+	}
+
+	if _, err := d.Query().SymbolMatches("[").Results(); err == nil {
+		t.Fatal("expected error for invalid symbol pattern, got nil")
+	}
+
+	if _, err := d.Query().OrderBy(InvalidSortKey, false).Results(); err == nil {
+		t.Fatal("expected error for invalid sort key, got nil")
+	}
+}
+
+func TestNameIndex(t *testing.T) {
+
+	idx := loadListing(t).NameIndex()
+
+	if infos := idx.Lookup("  apple   INC "); len(infos) != 1 || infos[0].Symbol != "AAPL" {
+		t.Fatalf("unexpected lookup: %v", infos)
+	}
+
+	infos := idx.WithPrefix("International Business")
+	if len(infos) == 0 {
+		t.Fatal("expected prefix matches")
+	}
+	for _, info := range infos {
+		if normaliseName(info.Name)[:22] != "INTERNATIONAL BUSINESS" {
+			t.Fatalf("unexpected prefix match: %v", info)
+		}
+	}
+
+	infos = idx.WithWord("microsoft")
+	found := false
+	for _, info := range infos {
+		found = found || info.Symbol == "MSFT"
+	}
+	if !found {
+		t.Fatalf("expected MSFT in word matches: %v", infos)
+	}
+
+	if len(idx.Lookup("no such company name")) != 0 {
+		t.Fatal("unexpected lookup match")
+	}
+}
+
+func TestQuery_2(t *testing.T) {
+
+	d := loadListing(t)
+
+	nasdaq := d.Query().OnExchanges("NASDAQ").OrderBy(ByName, false)
+
+	stocks, err := nasdaq.OfTypes(Stock).Count()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	etfs, err := nasdaq.OfTypes(ETF).Count()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	all, err := nasdaq.Count()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Each branch extends the shared Query independently, rather than combining their predicates
+	if stocks == 0 || etfs == 0 || stocks+etfs > all {
+		t.Fatalf("unexpected counts: %d stocks, %d ETFs, %d in total", stocks, etfs, all)
+	}
+
+	// Errors and limits are not shared between branches either
+	if _, err := nasdaq.Limit(-1).Results(); err == nil {
+		t.Fatal("expected error for invalid limit, got nil")
+	}
+
+	limited, err := nasdaq.Limit(2).Results()
+	if err != nil || len(limited) != 2 {
+		t.Fatalf("unexpected results: %v (%v)", limited, err)
+	}
+
+	infos, err := nasdaq.Results()
+	if err != nil || len(infos) != all {
+		t.Fatalf("unexpected results: %d (%v)", len(infos), err)
+	}
+}
+
+func TestQuery_3(t *testing.T) {
+
+	active := loadListing(t)
+
+	data, err := os.ReadFile("../example_data/delisted_listing_status.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var o = defaultOptions
+	o.State = Delisted
+	delisted, err := parseListingCsv(bytes.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	universe := Merge(active, delisted)
+	date := time.Date(2015, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	// The earlier listing of the reused symbol was active on the date, so is returned rather than the latest
+	infos, err := universe.Query().ActiveOn(date).SymbolMatches("ACQX").Results()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(infos) != 1 || infos[0].Name != "Acquisition Corp X" {
+		t.Fatalf("unexpected results: %v", infos)
+	}
+
+	// Without a date, the most recent listing is returned
+	infos, err = universe.Query().SymbolMatches("ACQX").Results()
+	if err != nil || len(infos) != 1 || infos[0].Name != "Acquisition Corp X II" {
+		t.Fatalf("unexpected results: %v (%v)", infos, err)
+	}
+
+	// The query agrees with Data.ActiveOn
+	n, err := universe.Query().ActiveOn(date).Count()
+	if err != nil || n != len(universe.ActiveOn(date).Tradeables) {
+		t.Fatalf("unexpected count: %d (%v)", n, err)
+	}
+
+	sub, err := universe.Query().SymbolMatches("ACQX").Data()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sub.History["ACQX"]) != 2 || !sub.ActiveOn(date).Contains("ACQX") {
+		t.Fatalf("unexpected history: %v", sub.History["ACQX"])
+	}
+}
@@ -0,0 +1,181 @@
+package listing
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// snapshotPrefix and snapshotSuffix surround the date in the name of a snapshot file
+const (
+	snapshotPrefix = "listing_"
+	snapshotSuffix = ".csv"
+)
+
+// ErrNoSnapshot returned when there is no snapshot for the requested date
+var ErrNoSnapshot = errors.New("no listing snapshot available")
+
+// ErrInvalidInfo returned when an entry cannot be written, because its AssetType or Status is invalid
+var ErrInvalidInfo = errors.New("invalid listing entry")
+
+// Write outputs every listing as CSV in the LISTING_STATUS format, ordered by symbol and listing date, so that
+// the output can be read by ReadSnapshot.  Pending entries are not written, since the format
+// cannot represent them.  Nothing is written if any entry has an invalid AssetType or Status.
+func (d *Data) Write(w io.Writer) error {
+
+	symbols := make([]Symbol, 0, len(d.Tradeables))
+	for symbol, info := range d.Tradeables {
+		if !info.Pending {
+			symbols = append(symbols, symbol)
+		}
+	}
+	slices.Sort(symbols)
+
+	records := [][]string{
+		{"symbol", "name", "exchange", "assetType", "ipoDate", "delistingDate", "status"},
+	}
+
+	for _, symbol := range symbols {
+		for _, info := range d.listings(symbol) {
+			if !info.Type.isValid() || !info.Status.isValid() {
+				return fmt.Errorf("%s: asset type %d, status %d: %w", info.Symbol, info.Type, info.Status, ErrInvalidInfo)
+			}
+
+			delisted := "null"
+			if !info.Delisted.IsZero() {
+				delisted = info.Delisted.Format(time.DateOnly)
+			}
+
+			records = append(records, []string{
+				string(info.Symbol),
+				info.Name,
+				string(info.Exchange),
+				info.Type.String(),
+				info.IPO.Format(time.DateOnly),
+				delisted,
+				info.Status.String(),
+			})
+		}
+	}
+
+	return csv.NewWriter(w).WriteAll(records)
+}
+
+// ReadSnapshot reads a listing in the LISTING_STATUS format, such as one created by Data.Write.
+// Both active and delisted entries are returned, unless restricted by the Options.
+func ReadSnapshot(r io.Reader, opts ...func(*Options) error) (*Data, error) {
+
+	var o = defaultOptions
+	o.State = UnknownStatus
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	return parseListingCsv(r, &o)
+}
+
+// snapshotPath returns the path of the snapshot for the date within the directory
+func snapshotPath(dir string, date time.Time) string {
+	return filepath.Join(dir, snapshotPrefix+date.Format(time.DateOnly)+snapshotSuffix)
+}
+
+// SaveSnapshot writes the listing to the directory as the snapshot for the specified date,
+// replacing any existing snapshot for that date.  The path of the snapshot is returned.
+func SaveSnapshot(dir string, date time.Time, d *Data) (string, error) {
+
+	path := snapshotPath(dir, date)
+
+	// Write to a temporary file first, so that an existing snapshot is not lost on failure
+	f, err := os.CreateTemp(dir, snapshotPrefix+"*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+
+	if err := d.Write(f); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// LoadSnapshot reads the snapshot for the specified date from the directory
+func LoadSnapshot(dir string, date time.Time, opts ...func(*Options) error) (*Data, error) {
+
+	f, err := os.Open(snapshotPath(dir, date))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%s: %w", date.Format(time.DateOnly), ErrNoSnapshot)
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadSnapshot(f, opts...)
+}
+
+// SnapshotDates returns the dates of the snapshots in the directory, in ascending order
+func SnapshotDates(dir string) ([]time.Time, error) {
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := []time.Time{}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, snapshotPrefix) || !strings.HasSuffix(name, snapshotSuffix) {
+			continue
+		}
+		date, err := time.Parse(time.DateOnly, strings.TrimSuffix(strings.TrimPrefix(name, snapshotPrefix), snapshotSuffix))
+		if err != nil {
+			continue // Not a snapshot
+		}
+		dates = append(dates, date)
+	}
+
+	slices.SortFunc(dates, func(a, b time.Time) int { return a.Compare(b) })
+	return dates, nil
+}
+
+// DiffLatestSnapshots compares the two most recent snapshots in the directory, returning the
+// dates of the snapshots together with the changes between them
+func DiffLatestSnapshots(dir string) (before, after time.Time, changes Changes, err error) {
+
+	dates, err := SnapshotDates(dir)
+	if err != nil {
+		return before, after, nil, err
+	}
+	if len(dates) < 2 {
+		return before, after, nil, fmt.Errorf("at least two snapshots are required, found %d: %w", len(dates), ErrNoSnapshot)
+	}
+
+	before, after = dates[len(dates)-2], dates[len(dates)-1]
+
+	b, err := LoadSnapshot(dir, before)
+	if err != nil {
+		return before, after, nil, err
+	}
+	a, err := LoadSnapshot(dir, after)
+	if err != nil {
+		return before, after, nil, err
+	}
+
+	return before, after, Diff(b, a), nil
+}
@@ -0,0 +1,126 @@
+package listing
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWrite(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/listing_status.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var o = defaultOptions
+
+	result, err := parseListingCsv(bytes.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := result.Write(&buf); err != nil {
+		t.Fatalf("failed to write snapshot: %v", err)
+	}
+
+	snapshot, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("failed to read snapshot: %v", err)
+	}
+
+	if len(snapshot.Tradeables) != len(result.Tradeables) {
+		t.Fatalf("expected %d entries, got %d", len(result.Tradeables), len(snapshot.Tradeables))
+	}
+
+	if changes := Diff(result, snapshot); len(changes) != 0 {
+		t.Fatalf("unexpected changes after round trip: %d", len(changes))
+	}
+
+	if *snapshot.Tradeables["IBM"] != *result.Tradeables["IBM"] {
+		t.Fatalf("unexpected IBM entry: %v", snapshot.Tradeables["IBM"])
+	}
+}
+
+func TestWrite_1(t *testing.T) {
+
+	for _, info := range []*Info{
+		{Symbol: "AAA", Name: "No Status", Exchange: "NYSE", Type: Stock},
+		{Symbol: "AAA", Name: "No Type", Exchange: "NYSE", Status: Active},
+	} {
+		d := &Data{
+			Tradeables: map[Symbol]*Info{info.Symbol: info},
+		}
+
+		var buf bytes.Buffer
+		if err := d.Write(&buf); !errors.Is(err, ErrInvalidInfo) {
+			t.Fatalf("%s: expected ErrInvalidInfo, got %v", info.Name, err)
+		}
+		if buf.Len() != 0 {
+			t.Fatalf("%s: unexpected output: %s", info.Name, buf.String())
+		}
+	}
+}
+
+func TestSaveSnapshot(t *testing.T) {
+
+	dir := t.TempDir()
+
+	ipo := time.Date(2001, 1, 2, 0, 0, 0, 0, time.UTC)
+	day1 := time.Date(2025, 8, 21, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 8, 22, 0, 0, 0, 0, time.UTC)
+
+	if _, _, _, err := DiffLatestSnapshots(dir); !errors.Is(err, ErrNoSnapshot) {
+		t.Fatalf("unexpected error: expected %v, got %v", ErrNoSnapshot, err)
+	}
+
+	before := &Data{
+		Tradeables: map[Symbol]*Info{
+			"AAA": {Symbol: "AAA", Name: "Alpha Inc", Exchange: "NYSE", Type: Stock, IPO: ipo, Status: Active},
+			"BBB": {Symbol: "BBB", Name: "Beta Corp", Exchange: "NASDAQ", Type: Stock, IPO: ipo, Status: Active},
+		},
+	}
+
+	after := &Data{
+		Tradeables: map[Symbol]*Info{
+			"AAA": {Symbol: "AAA", Name: "Alpha Inc", Exchange: "NYSE", Type: Stock, IPO: ipo, Status: Active},
+			"CCC": {Symbol: "CCC", Name: "Gamma Ltd", Exchange: "NYSE", Type: Stock, IPO: day2, Status: Active},
+			"PND": {Symbol: "PND", Name: "Pending Co", Exchange: "NYSE", IPO: day2, Pending: true},
+		},
+	}
+
+	if _, err := SaveSnapshot(dir, day2, after); err != nil {
+		t.Fatalf("failed to save snapshot: %v", err)
+	}
+	if _, err := SaveSnapshot(dir, day1, before); err != nil {
+		t.Fatalf("failed to save snapshot: %v", err)
+	}
+
+	dates, err := SnapshotDates(dir)
+	if err != nil {
+		t.Fatalf("failed to list snapshots: %v", err)
+	}
+	if len(dates) != 2 || dates[0] != day1 || dates[1] != day2 {
+		t.Fatalf("unexpected snapshot dates: %v", dates)
+	}
+
+	b, a, changes, err := DiffLatestSnapshots(dir)
+	if err != nil {
+		t.Fatalf("failed to diff snapshots: %v", err)
+	}
+	if b != day1 || a != day2 {
+		t.Fatalf("unexpected snapshot dates: %v, %v", b, a)
+	}
+
+	// Pending entries are not persisted
+	if len(changes) != 2 || changes[0].Type != Removed || changes[1].Type != Added || changes[1].Symbol != "CCC" {
+		t.Fatalf("unexpected changes: %+v", changes)
+	}
+
+	if _, err := LoadSnapshot(dir, time.Date(2025, 8, 20, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrNoSnapshot) {
+		t.Fatalf("unexpected error: expected %v, got %v", ErrNoSnapshot, err)
+	}
+}
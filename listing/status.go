@@ -0,0 +1,53 @@
+package listing
+
+import "fmt"
+
+// Status is the listing state of a symbol
+type Status int
+
+const (
+	UnknownStatus Status = iota
+	Active
+	Delisted
+	InvalidStatus
+)
+
+func (s Status) String() string {
+	switch s {
+	case Active:
+		return "Active"
+	case Delisted:
+		return "Delisted"
+	default:
+		panic("invalid value of Status")
+	}
+}
+
+func (s Status) toAVString() string {
+	switch s {
+	case Active:
+		return "active"
+	case Delisted:
+		return "delisted"
+	default:
+		panic("invalid value of Status")
+	}
+}
+
+func (s Status) isValid() bool {
+	if s <= UnknownStatus || s >= InvalidStatus {
+		return false
+	}
+	return true
+}
+
+func parseStatus(s string) (Status, error) {
+	switch s {
+	case "Active":
+		return Active, nil
+	case "Delisted":
+		return Delisted, nil
+	default:
+		return UnknownStatus, fmt.Errorf("unable to parse Status from: %s", s)
+	}
+}
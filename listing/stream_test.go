@@ -0,0 +1,104 @@
+package listing
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/listing_status.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	var o = defaultOptions
+
+	all, err := parseListingCsv(bytes.NewReader(data), &o)
+	if err != nil {
+		t.Fatalf("failed to parse CSV data: %v", err)
+	}
+
+	var n int
+	for info, err := range Stream(bytes.NewReader(data), WithOnlyTypes([]AssetType{ETF})) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if info.Type != ETF {
+			t.Fatalf("unexpected entry: %v", info)
+		}
+		if *info != *all.Tradeables[info.Symbol] {
+			t.Fatalf("streamed entry differs from parsed entry: %v", info)
+		}
+		n++
+	}
+
+	if n == 0 || n >= len(all.Tradeables) {
+		t.Fatalf("unexpected number of ETFs: %d", n)
+	}
+
+	// Iteration can be stopped early
+	n = 0
+	for _, err := range Stream(bytes.NewReader(data)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		n++
+		if n == 10 {
+			break
+		}
+	}
+	if n != 10 {
+		t.Fatalf("expected to stop after 10 entries, got %d", n)
+	}
+}
+
+func TestStream_1(t *testing.T) {
+
+	data := `symbol,name,exchange,assetType,ipoDate,delistingDate,status
+AAA,Good Stock,NYSE,Stock,2001-01-02,null,Active
+CCC,Odd Type,NYSE,Widget,2003-05-06,null,Active
+GGG,Good Warrant,NYSE,Warrant,2020-07-08,null,Active
+`
+
+	// Strict mode stops at the first issue
+	var symbols []Symbol
+	var issues int
+	for info, err := range Stream(strings.NewReader(data)) {
+		if err != nil {
+			var issue *RowIssue
+			if !errors.As(err, &issue) || issue.Line != 2 {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			issues++
+			continue
+		}
+		symbols = append(symbols, info.Symbol)
+	}
+	if len(symbols) != 1 || issues != 1 {
+		t.Fatalf("unexpected strict results: %v, %d issues", symbols, issues)
+	}
+
+	// Lenient mode continues after an issue
+	symbols, issues = nil, 0
+	for info, err := range Stream(strings.NewReader(data), WithLenient(true)) {
+		if err != nil {
+			issues++
+			continue
+		}
+		symbols = append(symbols, info.Symbol)
+	}
+	if len(symbols) != 2 || issues != 1 {
+		t.Fatalf("unexpected lenient results: %v, %d issues", symbols, issues)
+	}
+
+	// Option errors are yielded
+	for _, err := range Stream(strings.NewReader(data), WithState(InvalidStatus)) {
+		if err == nil {
+			t.Fatal("expected error for invalid option, got nil")
+		}
+	}
+}
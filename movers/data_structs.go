@@ -0,0 +1,37 @@
+package movers
+
+import "time"
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Description is the description of the data provided by Alpha Vantage
+	Description string
+	// LastUpdated is the time the lists were last updated, in the time zone reported by Alpha Vantage
+	LastUpdated time.Time
+}
+
+// Mover is a single ticker in one of the lists
+type Mover struct {
+	// Ticker is the symbol of the tradeable
+	Ticker string
+	// Price is the latest price
+	Price float64
+	// ChangeAmount is the change in price over the trading day
+	ChangeAmount float64
+	// ChangePercentage is the change in price over the trading day, as a percentage (e.g. 12.5 is 12.5%)
+	ChangePercentage float64
+	// Volume is the traded volume over the trading day
+	Volume int64
+}
+
+// Data is the returned object from a call to GetData
+type Data struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// TopGainers lists the tickers with the largest percentage gains
+	TopGainers []*Mover
+	// TopLosers lists the tickers with the largest percentage losses
+	TopLosers []*Mover
+	// MostActivelyTraded lists the tickers with the largest volume
+	MostActivelyTraded []*Mover
+}
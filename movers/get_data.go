@@ -0,0 +1,158 @@
+package movers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	// Ensures time zones such as US/Eastern are available on all platforms
+	_ "time/tzdata"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// respJSON captures all possible return JSON
+type respJSON struct {
+	Info               *string           `json:"Information"`
+	Err                *string           `json:"Error Message"`
+	Metadata           string            `json:"metadata"`
+	LastUpdated        string            `json:"last_updated"`
+	TopGainers         *[]*respMoverJSON `json:"top_gainers"`
+	TopLosers          *[]*respMoverJSON `json:"top_losers"`
+	MostActivelyTraded *[]*respMoverJSON `json:"most_actively_traded"`
+}
+
+type respMoverJSON struct {
+	Ticker           string `json:"ticker"`
+	Price            string `json:"price"`
+	ChangeAmount     string `json:"change_amount"`
+	ChangePercentage string `json:"change_percentage"`
+	Volume           string `json:"volume"`
+}
+
+// GetData uses the provided apiKey to retrieve the top gainers, losers and most actively traded US tickers
+func GetData(apiKey string) (*Data, error) {
+
+	url, err := common.NewQuery("TOP_GAINERS_LOSERS").URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseJSON(b)
+}
+
+func parseJSON(b []byte) (*Data, error) {
+	var d respJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+
+	t, err := parseLastUpdated(d.LastUpdated)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrMetadataParseError)
+	}
+
+	result := &Data{
+		Meta: &Metadata{
+			Description: d.Metadata,
+			LastUpdated: t,
+		},
+	}
+
+	for _, l := range []struct {
+		name string
+		src  *[]*respMoverJSON
+		dest *[]*Mover
+	}{
+		{"top_gainers", d.TopGainers, &result.TopGainers},
+		{"top_losers", d.TopLosers, &result.TopLosers},
+		{"most_actively_traded", d.MostActivelyTraded, &result.MostActivelyTraded},
+	} {
+		if l.src == nil {
+			return nil, fmt.Errorf("no %s available to be parsed: %w", l.name, common.ErrParseError)
+		}
+		movers, err := parseMovers(*l.src)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v: %w", l.name, err, common.ErrParseError)
+		}
+		*l.dest = movers
+	}
+
+	return result, nil
+}
+
+// parseLastUpdated parses values such as "2025-08-22 16:15:59 US/Eastern", where the
+// trailing time zone name determines the location of the returned time
+func parseLastUpdated(s string) (time.Time, error) {
+	i := strings.LastIndex(s, " ")
+	if i < 0 {
+		return time.Time{}, fmt.Errorf("invalid last_updated: %s", s)
+	}
+
+	loc, err := time.LoadLocation(s[i+1:])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time zone in last_updated %s: %v", s, err)
+	}
+
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", s[:i], loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid last_updated %s: %v", s, err)
+	}
+	return t, nil
+}
+
+func parseMovers(src []*respMoverJSON) ([]*Mover, error) {
+	result := []*Mover{}
+	for _, m := range src {
+		if m == nil {
+			return nil, errors.New("unexpected null entry")
+		}
+
+		mover := &Mover{
+			Ticker: m.Ticker,
+		}
+
+		var err error
+		if mover.Price, err = strconv.ParseFloat(m.Price, 64); err != nil {
+			return nil, fmt.Errorf("invalid price %s for %s: %v", m.Price, m.Ticker, err)
+		}
+		if mover.ChangeAmount, err = strconv.ParseFloat(m.ChangeAmount, 64); err != nil {
+			return nil, fmt.Errorf("invalid change amount %s for %s: %v", m.ChangeAmount, m.Ticker, err)
+		}
+		if mover.ChangePercentage, err = strconv.ParseFloat(strings.TrimSuffix(m.ChangePercentage, "%"), 64); err != nil {
+			return nil, fmt.Errorf("invalid change percentage %s for %s: %v", m.ChangePercentage, m.Ticker, err)
+		}
+		if mover.Volume, err = strconv.ParseInt(m.Volume, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid volume %s for %s: %v", m.Volume, m.Ticker, err)
+		}
+
+		result = append(result, mover)
+	}
+	return result, nil
+}
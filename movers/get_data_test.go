@@ -0,0 +1,55 @@
+package movers
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestParseJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/top_gainers_losers.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseJSON(data)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if len(result.TopGainers) != 2 || len(result.TopLosers) != 1 || len(result.MostActivelyTraded) != 3 {
+		t.Fatalf("unexpected list sizes: %d, %d, %d", len(result.TopGainers), len(result.TopLosers), len(result.MostActivelyTraded))
+	}
+
+	// 16:15:59 EDT is 20:15:59 UTC
+	if !result.Meta.LastUpdated.Equal(time.Date(2025, 8, 22, 20, 15, 59, 0, time.UTC)) {
+		t.Fatalf("unexpected last updated: %v", result.Meta.LastUpdated)
+	}
+
+	if result.Meta.LastUpdated.Location().String() != "US/Eastern" {
+		t.Fatalf("unexpected location: %v", result.Meta.LastUpdated.Location())
+	}
+
+	m := result.TopLosers[0]
+	if m.Ticker != "HIJK" || !common.EqualFloat64(-69.25, m.ChangePercentage, 2) || !common.EqualFloat64(-0.0277, m.ChangeAmount, 4) {
+		t.Fatalf("unexpected loser: %v", m)
+	}
+
+	if result.MostActivelyTraded[1].Volume != 92415360 {
+		t.Fatalf("unexpected volume: %d", result.MostActivelyTraded[1].Volume)
+	}
+}
+
+func TestParseLastUpdated(t *testing.T) {
+
+	if _, err := parseLastUpdated("2025-08-22 16:15:59"); err == nil {
+		t.Fatal("expected error for missing time zone, got nil")
+	}
+
+	if _, err := parseLastUpdated("2025-08-22 16:15:59 Not/AZone"); err == nil {
+		t.Fatal("expected error for invalid time zone, got nil")
+	}
+}
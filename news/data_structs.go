@@ -0,0 +1,79 @@
+package news
+
+import (
+	"time"
+)
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Items is the number of articles returned
+	Items int
+	// SentimentScoreDefinition describes how sentiment scores map to sentiment labels
+	SentimentScoreDefinition string
+	// RelevanceScoreDefinition describes the range of the relevance scores
+	RelevanceScoreDefinition string
+}
+
+// TopicRelevance is the relevance of an article to a topic
+type TopicRelevance struct {
+	// Topic is the topic covered by the article
+	Topic Topic
+	// Relevance is between 0 and 1, with higher scores being more relevant
+	Relevance float64
+}
+
+// TickerSentiment is the sentiment of an article towards a specific ticker
+type TickerSentiment struct {
+	// Ticker is the ticker mentioned in the article
+	Ticker string
+	// Relevance is between 0 and 1, with higher scores being more relevant
+	Relevance float64
+	// SentimentScore is between -1 (bearish) and 1 (bullish)
+	SentimentScore float64
+	// SentimentLabel is Alpha Vantage's classification of the SentimentScore, e.g. "Somewhat-Bullish"
+	SentimentLabel string
+}
+
+// Article is a single item in the news feed
+type Article struct {
+	// Title is the headline of the article
+	Title string
+	// URL is the location of the article
+	URL string
+	// TimePublished is when the article was published
+	TimePublished time.Time
+	// Authors lists the authors of the article
+	Authors []string
+	// Summary is a short description of the article
+	Summary string
+	// Source is the publisher of the article
+	Source string
+	// SourceDomain is the domain of the publisher
+	SourceDomain string
+	// Topics lists the topics covered by the article
+	Topics []*TopicRelevance
+	// OverallSentimentScore is between -1 (bearish) and 1 (bullish)
+	OverallSentimentScore float64
+	// OverallSentimentLabel is Alpha Vantage's classification of the OverallSentimentScore
+	OverallSentimentLabel string
+	// TickerSentiment lists the sentiment of the article for each ticker it mentions
+	TickerSentiment []*TickerSentiment
+}
+
+// SentimentFor returns the sentiment of the article for the specified ticker, if it is mentioned
+func (a *Article) SentimentFor(ticker string) (*TickerSentiment, bool) {
+	for _, ts := range a.TickerSentiment {
+		if ts.Ticker == ticker {
+			return ts, true
+		}
+	}
+	return nil, false
+}
+
+// Data is the returned object from a call to GetData
+type Data struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// Feed lists the articles, in the requested sort order
+	Feed []*Article
+}
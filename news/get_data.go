@@ -0,0 +1,205 @@
+package news
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// respJSON captures all possible return JSON
+type respJSON struct {
+	Info                     *string             `json:"Information"`
+	Err                      *string             `json:"Error Message"`
+	Items                    string              `json:"items"`
+	SentimentScoreDefinition string              `json:"sentiment_score_definition"`
+	RelevanceScoreDefinition string              `json:"relevance_score_definition"`
+	Feed                     *[]*respArticleJSON `json:"feed"`
+}
+
+type respArticleJSON struct {
+	Title                 string            `json:"title"`
+	URL                   string            `json:"url"`
+	TimePublished         string            `json:"time_published"`
+	Authors               []string          `json:"authors"`
+	Summary               string            `json:"summary"`
+	Source                string            `json:"source"`
+	SourceDomain          string            `json:"source_domain"`
+	Topics                []*respTopicJSON  `json:"topics"`
+	OverallSentimentScore float64           `json:"overall_sentiment_score"`
+	OverallSentimentLabel string            `json:"overall_sentiment_label"`
+	TickerSentiment       []*respTickerJSON `json:"ticker_sentiment"`
+}
+
+type respTopicJSON struct {
+	Topic          string `json:"topic"`
+	RelevanceScore string `json:"relevance_score"`
+}
+
+type respTickerJSON struct {
+	Ticker         string `json:"ticker"`
+	RelevanceScore string `json:"relevance_score"`
+	SentimentScore string `json:"ticker_sentiment_score"`
+	SentimentLabel string `json:"ticker_sentiment_label"`
+}
+
+// timeFormat is used by Alpha Vantage for time_published, time_from and time_to
+const timeFormat = "20060102T150405"
+
+// requestTimeFormat is the precision accepted by time_from and time_to
+const requestTimeFormat = "20060102T1504"
+
+// GetData uses the provided apiKey to retrieve news articles and their sentiment
+func GetData(apiKey string, opts ...func(*Options) error) (*Data, error) {
+
+	o := defaultOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	url, err := buildURL(apiKey, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseJSON(b)
+}
+
+func buildURL(apiKey string, o *Options) (string, error) {
+
+	q := common.NewQuery("NEWS_SENTIMENT")
+	if len(o.Tickers) > 0 {
+		q.SetList("tickers", o.Tickers)
+	}
+	if len(o.Topics) > 0 {
+		topics := []string{}
+		for _, t := range o.Topics {
+			topics = append(topics, t.toAVString())
+		}
+		q.SetList("topics", topics)
+	}
+	if !o.TimeFrom.IsZero() {
+		q.Set("time_from", o.TimeFrom.Format(requestTimeFormat))
+	}
+	if !o.TimeTo.IsZero() {
+		q.Set("time_to", o.TimeTo.Format(requestTimeFormat))
+	}
+	q.Set("sort", o.Sort.String()).SetInt("limit", o.Limit)
+
+	return q.URL(apiKey)
+}
+
+func parseJSON(b []byte) (*Data, error) {
+	var d respJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+	if d.Feed == nil {
+		return nil, fmt.Errorf("no feed available to be parsed: %w", common.ErrParseError)
+	}
+
+	result := &Data{
+		Meta: &Metadata{
+			SentimentScoreDefinition: d.SentimentScoreDefinition,
+			RelevanceScoreDefinition: d.RelevanceScoreDefinition,
+		},
+		Feed: []*Article{},
+	}
+
+	if d.Items != "" {
+		n, err := strconv.Atoi(d.Items)
+		if err != nil {
+			return nil, fmt.Errorf("invalid items %s: %w", d.Items, common.ErrMetadataParseError)
+		}
+		result.Meta.Items = n
+	}
+
+	for _, a := range *d.Feed {
+		article, err := parseArticle(a)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+		}
+		result.Feed = append(result.Feed, article)
+	}
+
+	return result, nil
+}
+
+func parseArticle(a *respArticleJSON) (*Article, error) {
+
+	t, err := time.Parse(timeFormat, a.TimePublished)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time_published %s for %s: %v", a.TimePublished, a.URL, err)
+	}
+
+	article := &Article{
+		Title:                 a.Title,
+		URL:                   a.URL,
+		TimePublished:         t,
+		Authors:               a.Authors,
+		Summary:               a.Summary,
+		Source:                a.Source,
+		SourceDomain:          a.SourceDomain,
+		Topics:                []*TopicRelevance{},
+		OverallSentimentScore: a.OverallSentimentScore,
+		OverallSentimentLabel: a.OverallSentimentLabel,
+		TickerSentiment:       []*TickerSentiment{},
+	}
+
+	for _, tp := range a.Topics {
+		r, err := strconv.ParseFloat(tp.RelevanceScore, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid relevance %s for topic %s: %v", tp.RelevanceScore, tp.Topic, err)
+		}
+		article.Topics = append(article.Topics, &TopicRelevance{
+			Topic:     parseTopic(tp.Topic),
+			Relevance: r,
+		})
+	}
+
+	for _, ts := range a.TickerSentiment {
+		r, err := strconv.ParseFloat(ts.RelevanceScore, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid relevance %s for ticker %s: %v", ts.RelevanceScore, ts.Ticker, err)
+		}
+		s, err := strconv.ParseFloat(ts.SentimentScore, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sentiment %s for ticker %s: %v", ts.SentimentScore, ts.Ticker, err)
+		}
+		article.TickerSentiment = append(article.TickerSentiment, &TickerSentiment{
+			Ticker:         ts.Ticker,
+			Relevance:      r,
+			SentimentScore: s,
+			SentimentLabel: ts.SentimentLabel,
+		})
+	}
+
+	return article, nil
+}
@@ -0,0 +1,166 @@
+package news
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/historic"
+)
+
+func TestParseJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_news.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseJSON(data)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Items != 4 || len(result.Feed) != 4 {
+		t.Fatalf("expected 4 articles, got %d (%d)", len(result.Feed), result.Meta.Items)
+	}
+
+	a := result.Feed[1]
+
+	if a.TimePublished != time.Date(2025, 8, 23, 14, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected time published: %v", a.TimePublished)
+	}
+
+	if len(a.Authors) != 2 {
+		t.Fatalf("expected 2 authors, got %v", a.Authors)
+	}
+
+	if len(a.Topics) != 2 || a.Topics[1].Topic != MergersAndAcquisitions {
+		t.Fatalf("unexpected topics: %v", a.Topics)
+	}
+
+	ts, ok := a.SentimentFor("IBM")
+	if !ok {
+		t.Fatal("expected IBM sentiment")
+	}
+
+	if !common.EqualFloat64(0.4, ts.SentimentScore, 3) || ts.SentimentLabel != "Bullish" {
+		t.Fatalf("unexpected sentiment: %v", ts)
+	}
+
+	if _, ok := result.Feed[0].SentimentFor("IBM"); ok {
+		t.Fatal("unexpected IBM sentiment in first article")
+	}
+}
+
+func TestSentimentSeries(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_news.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseJSON(data)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	elements := []*historic.Element{
+		{Date: time.Date(2025, 8, 25, 0, 0, 0, 0, time.UTC)},
+		{Date: time.Date(2025, 8, 22, 0, 0, 0, 0, time.UTC)},
+		{Date: time.Date(2025, 8, 21, 0, 0, 0, 0, time.UTC)},
+	}
+
+	series := result.SentimentSeries("IBM", elements)
+
+	if len(series) != len(elements) {
+		t.Fatalf("expected %d elements, got %d", len(elements), len(series))
+	}
+
+	// Saturday article rolls forward to Monday
+	if series[0].Articles != 1 || !common.EqualFloat64(0.4, series[0].Sentiment.Value, 3) {
+		t.Fatalf("unexpected Monday sentiment: %v", series[0])
+	}
+
+	// (0.75 * -0.3 + 0.25 * 0.1) / 1.0
+	if series[1].Articles != 2 || !common.EqualFloat64(-0.2, series[1].Sentiment.Value, 3) {
+		t.Fatalf("unexpected Friday sentiment: %v", series[1])
+	}
+
+	if !common.EqualFloat64(0.5, series[1].Relevance.Value, 3) {
+		t.Fatalf("unexpected Friday relevance: %v", series[1].Relevance)
+	}
+
+	if series[2].Articles != 0 || !series[2].Sentiment.IsUndefined() {
+		t.Fatalf("unexpected Thursday sentiment: %v", series[2])
+	}
+
+	// Articles after the last trading date are ignored
+	series = result.SentimentSeries("NVDA", elements)
+	for _, s := range series {
+		if s.Articles != 0 {
+			t.Fatalf("unexpected NVDA sentiment: %v", s)
+		}
+	}
+}
+
+func TestSentimentSeriesAfterClose(t *testing.T) {
+
+	article := func(published time.Time, score float64) *Article {
+		return &Article{
+			TimePublished:   published,
+			TickerSentiment: []*TickerSentiment{{Ticker: "IBM", Relevance: 1, SentimentScore: score}},
+		}
+	}
+
+	d := &Data{
+		Feed: []*Article{
+			article(time.Date(2025, 8, 21, 19, 59, 0, 0, time.UTC), 0.5), // 15:59 New York, before the close
+			article(time.Date(2025, 8, 21, 20, 0, 0, 0, time.UTC), -0.5), // 16:00 New York, at the close
+		},
+	}
+
+	elements := []*historic.Element{
+		{Date: time.Date(2025, 8, 22, 0, 0, 0, 0, time.UTC)},
+		{Date: time.Date(2025, 8, 21, 0, 0, 0, 0, time.UTC)},
+	}
+
+	series := d.SentimentSeries("IBM", elements)
+
+	if series[1].Articles != 1 || !common.EqualFloat64(0.5, series[1].Sentiment.Value, 3) {
+		t.Fatalf("unexpected Thursday sentiment: %v", series[1])
+	}
+	if series[0].Articles != 1 || !common.EqualFloat64(-0.5, series[0].Sentiment.Value, 3) {
+		t.Fatalf("unexpected Friday sentiment: %v", series[0])
+	}
+}
+
+func TestBuildURL(t *testing.T) {
+
+	o := defaultOptions
+	for _, opt := range []func(*Options) error{
+		WithTickers("ibm", "CRYPTO:BTC"),
+		WithTopics(Technology, IPO),
+		WithTimeRange(time.Date(2025, 8, 1, 9, 30, 0, 0, time.UTC), time.Time{}),
+		WithSortOrder(Relevance),
+		WithLimit(200),
+	} {
+		if err := opt(&o); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	expected := "https://www.alphavantage.co/query?apikey=KEY&function=NEWS_SENTIMENT&limit=200&sort=RELEVANCE&tickers=IBM%2CCRYPTO%3ABTC&time_from=20250801T0930&topics=technology%2Cipo"
+	if url, err := buildURL("KEY", &o); err != nil || url != expected {
+		t.Fatalf("unexpected url: expected %s, got %s", expected, url)
+	}
+
+	if err := WithTickers("IBM&apikey=X")(&o); !errors.Is(err, common.ErrInvalidSymbol) {
+		t.Fatalf("unexpected error: expected %v, got %v", common.ErrInvalidSymbol, err)
+	}
+
+	if err := WithLimit(1001)(&o); err == nil {
+		t.Fatal("expected error for invalid limit, got nil")
+	}
+}
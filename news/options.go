@@ -0,0 +1,107 @@
+package news
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// Options can change the articles returned by GetData
+type Options struct {
+	// Tickers restricts articles to those mentioning all of the tickers.  Default: no restriction
+	Tickers []string
+	// Topics restricts articles to those covering all of the topics.  Default: no restriction
+	Topics []Topic
+	// TimeFrom, if set, excludes articles published before this time
+	TimeFrom time.Time
+	// TimeTo, if set, excludes articles published after this time
+	TimeTo time.Time
+	// Sort determines the order of the articles.  Default: latest first
+	Sort SortOrder
+	// Limit is the maximum number of articles returned.  Default: 50
+	Limit int
+}
+
+// WithTickers restricts articles to those mentioning all of the tickers,
+// e.g. "IBM", "CRYPTO:BTC" or "FOREX:USD"
+func WithTickers(tickers ...string) func(*Options) error {
+	return func(o *Options) error {
+		t := []string{}
+		for _, ticker := range tickers {
+			if len(ticker) == 0 {
+				return errors.New("empty ticker specified")
+			}
+			ticker = strings.ToUpper(ticker)
+
+			// Crypto and forex tickers are prefixed, e.g. CRYPTO:BTC
+			symbol := ticker
+			if prefix, code, ok := strings.Cut(ticker, ":"); ok && (prefix == "CRYPTO" || prefix == "FOREX") {
+				symbol = code
+			}
+			if _, err := common.ParseSymbol(symbol); err != nil {
+				return err
+			}
+
+			t = append(t, ticker)
+		}
+		o.Tickers = t
+		return nil
+	}
+}
+
+// WithTopics restricts articles to those covering all of the topics
+func WithTopics(topics ...Topic) func(*Options) error {
+	return func(o *Options) error {
+		t := []Topic{}
+		for _, topic := range topics {
+			if !topic.isValid() {
+				return errors.New("invalid topic")
+			}
+			t = append(t, topic)
+		}
+		o.Topics = t
+		return nil
+	}
+}
+
+// WithTimeRange restricts articles to those published in the range.  A zero time leaves that end of the range open.
+func WithTimeRange(from, to time.Time) func(*Options) error {
+	return func(o *Options) error {
+		if !from.IsZero() && !to.IsZero() && to.Before(from) {
+			return fmt.Errorf("invalid time range: %v is before %v", to, from)
+		}
+		o.TimeFrom = from
+		o.TimeTo = to
+		return nil
+	}
+}
+
+// WithSortOrder sets the order of the returned articles
+func WithSortOrder(sort SortOrder) func(*Options) error {
+	return func(o *Options) error {
+		if !sort.isValid() {
+			return errors.New("invalid sort order")
+		}
+		o.Sort = sort
+		return nil
+	}
+}
+
+// WithLimit sets the maximum number of articles returned, which must be between 1 and 1000
+func WithLimit(n int) func(*Options) error {
+	return func(o *Options) error {
+		if n < 1 || n > 1000 {
+			return fmt.Errorf("invalid limit: %d", n)
+		}
+		o.Limit = n
+		return nil
+	}
+}
+
+var defaultOptions = Options{
+	Sort:  Latest,
+	Limit: 50,
+}
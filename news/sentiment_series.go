@@ -0,0 +1,95 @@
+package news
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/historic"
+)
+
+// SentimentElement is the aggregated sentiment of the articles aligned to a trading date
+type SentimentElement struct {
+	// Date is the trading date, taken from the historic.Element
+	Date time.Time
+	// Articles is the number of articles mentioning the ticker that are aligned to the date
+	Articles int
+	// Sentiment is the relevance weighted mean of the ticker sentiment scores,
+	// which is undefined if no articles are aligned to the date
+	Sentiment common.OptionalFloat64
+	// Relevance is the mean relevance of the articles to the ticker,
+	// which is undefined if no articles are aligned to the date
+	Relevance common.OptionalFloat64
+}
+
+// SentimentSeries aggregates the sentiment of the articles for the ticker into a series with
+// one element for each of the historic.Element dates, in the same order as the elements.
+// Articles published at or after the close, in the time zone of the exchange identified by the ticker's suffix
+// (US by default), or on non-trading days are rolled forward to the next trading date, so that they are aligned
+// with the first price that could reflect them; articles published after the last trading date are ignored.
+func (d *Data) SentimentSeries(ticker string, elements []*historic.Element) []*SentimentElement {
+
+	exchange := common.USExchange
+	if s, err := common.ParseSymbol(ticker); err == nil {
+		exchange = s.Exchange()
+	}
+
+	type totals struct {
+		count           int
+		relevance       float64
+		weightedScore   float64
+		unweightedScore float64
+	}
+
+	dates := make([]time.Time, 0, len(elements))
+	for _, e := range elements {
+		dates = append(dates, truncateToDay(e.Date))
+	}
+	slices.SortFunc(dates, func(a, b time.Time) int { return a.Compare(b) })
+
+	agg := map[time.Time]*totals{}
+	for _, a := range d.Feed {
+		ts, ok := a.SentimentFor(ticker)
+		if !ok {
+			continue
+		}
+
+		i, _ := slices.BinarySearchFunc(dates, exchange.SessionDate(a.TimePublished), func(e, t time.Time) int { return e.Compare(t) })
+		if i == len(dates) {
+			continue
+		}
+
+		t, ok := agg[dates[i]]
+		if !ok {
+			t = &totals{}
+			agg[dates[i]] = t
+		}
+		t.count++
+		t.relevance += ts.Relevance
+		t.weightedScore += ts.Relevance * ts.SentimentScore
+		t.unweightedScore += ts.SentimentScore
+	}
+
+	result := make([]*SentimentElement, 0, len(elements))
+	for _, e := range elements {
+		se := &SentimentElement{
+			Date: e.Date,
+		}
+		if t, ok := agg[truncateToDay(e.Date)]; ok {
+			se.Articles = t.count
+			se.Relevance = common.OptionalFloat64{Value: t.relevance / float64(t.count), Defined: true}
+			if t.relevance > 0 {
+				se.Sentiment = common.OptionalFloat64{Value: t.weightedScore / t.relevance, Defined: true}
+			} else {
+				se.Sentiment = common.OptionalFloat64{Value: t.unweightedScore / float64(t.count), Defined: true}
+			}
+		}
+		result = append(result, se)
+	}
+
+	return result
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
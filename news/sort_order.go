@@ -0,0 +1,32 @@
+package news
+
+// SortOrder determines the order of the returned articles
+type SortOrder int
+
+const (
+	UnknownSortOrder SortOrder = iota
+	Latest
+	Earliest
+	Relevance
+	InvalidSortOrder
+)
+
+func (s SortOrder) String() string {
+	switch s {
+	case Latest:
+		return "LATEST"
+	case Earliest:
+		return "EARLIEST"
+	case Relevance:
+		return "RELEVANCE"
+	default:
+		panic("invalid value of SortOrder")
+	}
+}
+
+func (s SortOrder) isValid() bool {
+	if s <= UnknownSortOrder || s >= InvalidSortOrder {
+		return false
+	}
+	return true
+}
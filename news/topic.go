@@ -0,0 +1,115 @@
+package news
+
+// Topic is a news topic that articles can be filtered by
+type Topic int
+
+const (
+	UnknownTopic Topic = iota
+	Blockchain
+	Earnings
+	IPO
+	MergersAndAcquisitions
+	FinancialMarkets
+	EconomyFiscal
+	EconomyMonetary
+	EconomyMacro
+	EnergyTransportation
+	Finance
+	LifeSciences
+	Manufacturing
+	RealEstate
+	RetailWholesale
+	Technology
+	InvalidTopic
+)
+
+func (t Topic) String() string {
+	switch t {
+	case Blockchain:
+		return "Blockchain"
+	case Earnings:
+		return "Earnings"
+	case IPO:
+		return "IPO"
+	case MergersAndAcquisitions:
+		return "Mergers & Acquisitions"
+	case FinancialMarkets:
+		return "Financial Markets"
+	case EconomyFiscal:
+		return "Economy - Fiscal"
+	case EconomyMonetary:
+		return "Economy - Monetary"
+	case EconomyMacro:
+		return "Economy - Macro"
+	case EnergyTransportation:
+		return "Energy & Transportation"
+	case Finance:
+		return "Finance"
+	case LifeSciences:
+		return "Life Sciences"
+	case Manufacturing:
+		return "Manufacturing"
+	case RealEstate:
+		return "Real Estate & Construction"
+	case RetailWholesale:
+		return "Retail & Wholesale"
+	case Technology:
+		return "Technology"
+	default:
+		panic("invalid value of Topic")
+	}
+}
+
+func (t Topic) toAVString() string {
+	switch t {
+	case Blockchain:
+		return "blockchain"
+	case Earnings:
+		return "earnings"
+	case IPO:
+		return "ipo"
+	case MergersAndAcquisitions:
+		return "mergers_and_acquisitions"
+	case FinancialMarkets:
+		return "financial_markets"
+	case EconomyFiscal:
+		return "economy_fiscal"
+	case EconomyMonetary:
+		return "economy_monetary"
+	case EconomyMacro:
+		return "economy_macro"
+	case EnergyTransportation:
+		return "energy_transportation"
+	case Finance:
+		return "finance"
+	case LifeSciences:
+		return "life_sciences"
+	case Manufacturing:
+		return "manufacturing"
+	case RealEstate:
+		return "real_estate"
+	case RetailWholesale:
+		return "retail_wholesale"
+	case Technology:
+		return "technology"
+	default:
+		panic("invalid value of Topic")
+	}
+}
+
+func (t Topic) isValid() bool {
+	if t <= UnknownTopic || t >= InvalidTopic {
+		return false
+	}
+	return true
+}
+
+// parseTopic returns the Topic from its description in the returned feed
+func parseTopic(s string) Topic {
+	for t := UnknownTopic + 1; t < InvalidTopic; t++ {
+		if t.String() == s {
+			return t
+		}
+	}
+	return UnknownTopic
+}
@@ -0,0 +1,31 @@
+package options
+
+// ContractType identifies whether the contract is a call or a put
+type ContractType int
+
+const (
+	UnknownContractType ContractType = iota
+	Call
+	Put
+	InvalidContractType
+)
+
+func (c ContractType) String() string {
+	switch c {
+	case Call:
+		return "call"
+	case Put:
+		return "put"
+	default:
+		panic("invalid value of ContractType")
+	}
+}
+
+func parseContractType(s string) (ContractType, error) {
+	for c := UnknownContractType + 1; c < InvalidContractType; c++ {
+		if c.String() == s {
+			return c, nil
+		}
+	}
+	return UnknownContractType, ErrInvalidContractType
+}
@@ -0,0 +1,140 @@
+package options
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Symbol is the requested underlying symbol
+	Symbol string
+	// Realtime is true if the chain was retrieved using GetRealtime
+	Realtime bool
+	// Date is the requested trading date of a historical chain, if specified
+	Date time.Time
+}
+
+// Contract describes a single option contract in the chain
+type Contract struct {
+	// ContractID is the identifier of the contract, e.g. IBM270115C00390000
+	ContractID string
+	// Symbol is the underlying symbol
+	Symbol string
+	// Expiration is the expiry date of the contract
+	Expiration time.Time
+	// Strike is the strike price of the contract
+	Strike float64
+	// Type identifies whether the contract is a call or a put
+	Type ContractType
+	// Date is the trading date of the prices
+	Date time.Time
+	// Last is the last traded price
+	Last float64
+	// Mark is the mid price
+	Mark float64
+	// Bid is the best bid price
+	Bid float64
+	// BidSize is the size available at the Bid
+	BidSize int64
+	// Ask is the best ask price
+	Ask float64
+	// AskSize is the size available at the Ask
+	AskSize int64
+	// Volume is the number of contracts traded
+	Volume int64
+	// OpenInterest is the number of contracts outstanding
+	OpenInterest int64
+	// ImpliedVolatility is annualised, e.g. 0.25 is 25%.  Undefined if greeks were not requested for a realtime chain
+	ImpliedVolatility common.OptionalFloat64
+	// Delta is undefined if greeks were not requested
+	Delta common.OptionalFloat64
+	// Gamma is undefined if greeks were not requested
+	Gamma common.OptionalFloat64
+	// Theta is undefined if greeks were not requested
+	Theta common.OptionalFloat64
+	// Vega is undefined if greeks were not requested
+	Vega common.OptionalFloat64
+	// Rho is undefined if greeks were not requested
+	Rho common.OptionalFloat64
+}
+
+// Data is the returned object from a call to GetRealtime or GetHistorical
+type Data struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// Contracts is the option chain, ordered by expiration, type and then strike
+	Contracts []*Contract
+}
+
+// Expirations returns the distinct expiry dates in the chain, in ascending order
+func (d *Data) Expirations() []time.Time {
+	result := []time.Time{}
+	for _, c := range d.Contracts {
+		if !slices.ContainsFunc(result, c.Expiration.Equal) {
+			result = append(result, c.Expiration)
+		}
+	}
+	slices.SortFunc(result, func(a, b time.Time) int { return a.Compare(b) })
+	return result
+}
+
+// filter returns a chain with the same Metadata, containing only the contracts satisfying f
+func (d *Data) filter(f func(c *Contract) bool) *Data {
+	result := &Data{
+		Meta:      d.Meta,
+		Contracts: []*Contract{},
+	}
+	for _, c := range d.Contracts {
+		if f(c) {
+			result.Contracts = append(result.Contracts, c)
+		}
+	}
+	return result
+}
+
+// ByExpiry returns the contracts that expire on the specified date
+func (d *Data) ByExpiry(expiration time.Time) *Data {
+	return d.filter(func(c *Contract) bool { return c.Expiration.Equal(expiration) })
+}
+
+// ByExpiryRange returns the contracts that expire between from and to inclusive
+func (d *Data) ByExpiryRange(from, to time.Time) *Data {
+	return d.filter(func(c *Contract) bool { return !c.Expiration.Before(from) && !c.Expiration.After(to) })
+}
+
+// ByStrikeRange returns the contracts with a strike between low and high inclusive
+func (d *Data) ByStrikeRange(low, high float64) *Data {
+	return d.filter(func(c *Contract) bool { return c.Strike >= low && c.Strike <= high })
+}
+
+// ByType returns the calls or the puts in the chain
+func (d *Data) ByType(t ContractType) *Data {
+	return d.filter(func(c *Contract) bool { return c.Type == t })
+}
+
+// AtTheMoney returns, for each expiry and type, the contract whose strike is closest to the price
+// of the underlying.  For example, the price can be the close from historic.Data on the chain's
+// trading date, allowing the implied volatility to be compared with realised volatility.
+func (d *Data) AtTheMoney(price float64) *Data {
+
+	type key struct {
+		expiration time.Time
+		t          ContractType
+	}
+
+	nearest := map[key]*Contract{}
+	for _, c := range d.Contracts {
+		k := key{expiration: c.Expiration, t: c.Type}
+		if n, ok := nearest[k]; !ok || math.Abs(c.Strike-price) < math.Abs(n.Strike-price) {
+			nearest[k] = c
+		}
+	}
+
+	return d.filter(func(c *Contract) bool {
+		return nearest[key{expiration: c.Expiration, t: c.Type}] == c
+	})
+}
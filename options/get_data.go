@@ -0,0 +1,247 @@
+package options
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// respJSON captures all possible return JSON
+type respJSON struct {
+	Info    *string              `json:"Information"`
+	Err     *string              `json:"Error Message"`
+	Message string               `json:"message"`
+	Data    *[]*respContractJSON `json:"data"`
+}
+
+type respContractJSON struct {
+	ContractID        string `json:"contractID"`
+	Symbol            string `json:"symbol"`
+	Expiration        string `json:"expiration"`
+	Strike            string `json:"strike"`
+	Type              string `json:"type"`
+	Last              string `json:"last"`
+	Mark              string `json:"mark"`
+	Bid               string `json:"bid"`
+	BidSize           string `json:"bid_size"`
+	Ask               string `json:"ask"`
+	AskSize           string `json:"ask_size"`
+	Volume            string `json:"volume"`
+	OpenInterest      string `json:"open_interest"`
+	Date              string `json:"date"`
+	ImpliedVolatility string `json:"implied_volatility"`
+	Delta             string `json:"delta"`
+	Gamma             string `json:"gamma"`
+	Theta             string `json:"theta"`
+	Vega              string `json:"vega"`
+	Rho               string `json:"rho"`
+}
+
+// ErrInvalidContractType returned when the type of a contract is neither call nor put
+var ErrInvalidContractType = errors.New("invalid contract type")
+
+// GetRealtime uses the provided apiKey to retrieve the realtime option chain for the symbol
+func GetRealtime(symbol, apiKey string, opts ...func(*Options) error) (*Data, error) {
+
+	o := defaultOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	q := common.NewQuery("REALTIME_OPTIONS").
+		Symbol(symbol).
+		SetBool("require_greeks", o.RequireGreeks)
+	if o.Contract != "" {
+		q.Set("contract", o.Contract)
+	}
+
+	return getData(q, apiKey, &Metadata{
+		Symbol:   strings.ToUpper(symbol),
+		Realtime: true,
+	})
+}
+
+// GetHistorical uses the provided apiKey to retrieve the option chain for the symbol at the end of a trading date
+func GetHistorical(symbol, apiKey string, opts ...func(*Options) error) (*Data, error) {
+
+	o := defaultOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	q := common.NewQuery("HISTORICAL_OPTIONS").Symbol(symbol)
+	if !o.Date.IsZero() {
+		q.SetDate("date", o.Date)
+	}
+
+	return getData(q, apiKey, &Metadata{
+		Symbol: strings.ToUpper(symbol),
+		Date:   o.Date,
+	})
+}
+
+func getData(q *common.Query, apiKey string, meta *Metadata) (*Data, error) {
+
+	url, err := q.URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseJSON(b, meta)
+}
+
+func parseJSON(b []byte, meta *Metadata) (*Data, error) {
+	var d respJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+	if d.Data == nil {
+		return nil, fmt.Errorf("no data available to be parsed (%s): %w", d.Message, common.ErrParseError)
+	}
+
+	result := &Data{
+		Meta:      meta,
+		Contracts: []*Contract{},
+	}
+
+	for _, c := range *d.Data {
+		contract, err := parseContract(c)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+		}
+		result.Contracts = append(result.Contracts, contract)
+	}
+
+	slices.SortFunc(result.Contracts, func(a, b *Contract) int {
+		if n := a.Expiration.Compare(b.Expiration); n != 0 {
+			return n
+		}
+		if a.Type != b.Type {
+			return int(a.Type) - int(b.Type)
+		}
+		switch {
+		case a.Strike < b.Strike:
+			return -1
+		case a.Strike > b.Strike:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return result, nil
+}
+
+func parseContract(c *respContractJSON) (*Contract, error) {
+
+	if c == nil {
+		return nil, errors.New("unexpected null contract")
+	}
+
+	contract := &Contract{
+		ContractID: c.ContractID,
+		Symbol:     c.Symbol,
+	}
+
+	var err error
+	if contract.Type, err = parseContractType(c.Type); err != nil {
+		return nil, fmt.Errorf("%s for %s: %w", c.Type, c.ContractID, err)
+	}
+
+	for _, v := range []struct {
+		name  string
+		s     string
+		value *time.Time
+	}{
+		{"expiration", c.Expiration, &contract.Expiration},
+		{"date", c.Date, &contract.Date},
+	} {
+		if *v.value, err = time.Parse(time.DateOnly, v.s); err != nil {
+			return nil, fmt.Errorf("invalid %s %s for %s: %v", v.name, v.s, c.ContractID, err)
+		}
+	}
+
+	for _, v := range []struct {
+		name  string
+		s     string
+		value *float64
+	}{
+		{"strike", c.Strike, &contract.Strike},
+		{"last", c.Last, &contract.Last},
+		{"mark", c.Mark, &contract.Mark},
+		{"bid", c.Bid, &contract.Bid},
+		{"ask", c.Ask, &contract.Ask},
+	} {
+		if *v.value, err = strconv.ParseFloat(v.s, 64); err != nil {
+			return nil, fmt.Errorf("invalid %s %s for %s: %v", v.name, v.s, c.ContractID, err)
+		}
+	}
+
+	for _, v := range []struct {
+		name  string
+		s     string
+		value *int64
+	}{
+		{"bid_size", c.BidSize, &contract.BidSize},
+		{"ask_size", c.AskSize, &contract.AskSize},
+		{"volume", c.Volume, &contract.Volume},
+		{"open_interest", c.OpenInterest, &contract.OpenInterest},
+	} {
+		if *v.value, err = strconv.ParseInt(v.s, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid %s %s for %s: %v", v.name, v.s, c.ContractID, err)
+		}
+	}
+
+	// Greeks are always present in historical chains, but only present in realtime chains if requested
+	for _, v := range []struct {
+		name  string
+		s     string
+		value *common.OptionalFloat64
+	}{
+		{"implied_volatility", c.ImpliedVolatility, &contract.ImpliedVolatility},
+		{"delta", c.Delta, &contract.Delta},
+		{"gamma", c.Gamma, &contract.Gamma},
+		{"theta", c.Theta, &contract.Theta},
+		{"vega", c.Vega, &contract.Vega},
+		{"rho", c.Rho, &contract.Rho},
+	} {
+		if *v.value, err = common.ParseOptionalFloat64(v.s); err != nil {
+			return nil, fmt.Errorf("invalid %s %s for %s: %v", v.name, v.s, c.ContractID, err)
+		}
+	}
+
+	return contract, nil
+}
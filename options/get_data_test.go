@@ -0,0 +1,129 @@
+package options
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestParseJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_options_historical.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseJSON(data, &Metadata{Symbol: "IBM"})
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if len(result.Contracts) != 12 {
+		t.Fatalf("expected 12 contracts, got %d", len(result.Contracts))
+	}
+
+	// Sorted by expiration, type and then strike
+	c := result.Contracts[0]
+	if c.ContractID != "IBM250829C00240000" || c.Type != Call || c.Strike != 240 {
+		t.Fatalf("unexpected first contract: %v", c)
+	}
+
+	if c.Expiration != time.Date(2025, 8, 29, 0, 0, 0, 0, time.UTC) || c.Date != time.Date(2025, 8, 22, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected dates: %v, %v", c.Expiration, c.Date)
+	}
+
+	if c.BidSize != 12 || c.OpenInterest != 1021 {
+		t.Fatalf("unexpected sizes: %d, %d", c.BidSize, c.OpenInterest)
+	}
+
+	if !common.EqualFloat64(0.2, c.ImpliedVolatility.Value, 5) || c.Delta.IsUndefined() {
+		t.Fatalf("unexpected greeks: %v, %v", c.ImpliedVolatility, c.Delta)
+	}
+}
+
+func TestParseJSON_1(t *testing.T) {
+
+	// Realtime chains omit the greeks unless requested
+	data := []byte(`{"endpoint": "Realtime Options", "message": "success", "data": [
+		{"contractID": "IBM250829P00245000", "symbol": "IBM", "expiration": "2025-08-29", "strike": "245.00", "type": "put",
+		 "last": "5.90", "mark": "5.95", "bid": "5.90", "bid_size": "3", "ask": "6.00", "ask_size": "4",
+		 "volume": "12", "open_interest": "310", "date": "2025-08-25"}]}`)
+
+	result, err := parseJSON(data, &Metadata{Symbol: "IBM", Realtime: true})
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	c := result.Contracts[0]
+	if c.Type != Put || !c.ImpliedVolatility.IsUndefined() || !c.Rho.IsUndefined() {
+		t.Fatalf("unexpected contract: %v", c)
+	}
+
+	data = []byte(`{"data": [{"contractID": "X", "type": "straddle"}]}`)
+	if _, err := parseJSON(data, &Metadata{}); !errors.Is(err, common.ErrParseError) {
+		t.Fatalf("unexpected error: expected %v, got %v", common.ErrParseError, err)
+	}
+}
+
+func TestChainHelpers(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_options_historical.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseJSON(data, &Metadata{Symbol: "IBM"})
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	expirations := result.Expirations()
+	if len(expirations) != 2 || !expirations[0].Before(expirations[1]) {
+		t.Fatalf("unexpected expirations: %v", expirations)
+	}
+
+	chain := result.ByExpiry(expirations[1]).ByType(Put).ByStrikeRange(242, 250)
+	if len(chain.Contracts) != 2 {
+		t.Fatalf("expected 2 contracts, got %d", len(chain.Contracts))
+	}
+
+	for _, c := range chain.Contracts {
+		if c.Type != Put || !c.Expiration.Equal(expirations[1]) || c.Strike < 242 {
+			t.Fatalf("unexpected contract: %v", c)
+		}
+	}
+
+	if chain.Meta != result.Meta {
+		t.Fatal("expected metadata to be retained")
+	}
+
+	if n := len(result.ByExpiryRange(expirations[0], expirations[0]).Contracts); n != 6 {
+		t.Fatalf("expected 6 contracts, got %d", n)
+	}
+
+	atm := result.AtTheMoney(242.1)
+	if len(atm.Contracts) != 4 {
+		t.Fatalf("expected 4 contracts, got %d", len(atm.Contracts))
+	}
+
+	for _, c := range atm.Contracts {
+		if c.Strike != 240 {
+			t.Fatalf("unexpected at the money strike: %v", c.Strike)
+		}
+	}
+}
+
+func TestGetHistorical(t *testing.T) {
+
+	// Invalid requests are rejected before any remote call is made
+	if _, err := GetHistorical("IBM", "KEY", WithDate(time.Date(2007, 12, 31, 0, 0, 0, 0, time.UTC))); err == nil {
+		t.Fatal("expected error for invalid date, got nil")
+	}
+
+	if _, err := GetRealtime("", "KEY"); err == nil {
+		t.Fatal("expected error for missing symbol, got nil")
+	}
+}
@@ -0,0 +1,53 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Options can change the returned Data from GetRealtime and GetHistorical
+type Options struct {
+	// Date selects the trading date of the historical chain.  Default: the previous trading session
+	Date time.Time
+	// Contract, if set, restricts the realtime chain to the specified contract ID.  Default: all contracts
+	Contract string
+	// RequireGreeks = true returns implied volatility and greeks in the realtime chain.  Default: false
+	RequireGreeks bool
+}
+
+// earliestDate is the first date for which historical chains are available
+var earliestDate = time.Date(2008, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// WithDate selects the trading date of the historical chain, which must be after 2008-01-01
+func WithDate(date time.Time) func(*Options) error {
+	return func(o *Options) error {
+		if date.Before(earliestDate) || date.After(time.Now()) {
+			return fmt.Errorf("invalid date specified: %s", date.Format(time.DateOnly))
+		}
+		o.Date = date
+		return nil
+	}
+}
+
+// WithContract restricts the realtime chain to the specified contract ID, e.g. IBM270115C00390000
+func WithContract(contractID string) func(*Options) error {
+	return func(o *Options) error {
+		if len(contractID) == 0 {
+			return errors.New("empty contract ID specified")
+		}
+		o.Contract = strings.ToUpper(contractID)
+		return nil
+	}
+}
+
+// WithRequireGreeks determines whether implied volatility and greeks are returned in the realtime chain
+func WithRequireGreeks(require bool) func(*Options) error {
+	return func(o *Options) error {
+		o.RequireGreeks = require
+		return nil
+	}
+}
+
+var defaultOptions = Options{}
@@ -0,0 +1,33 @@
+package quote
+
+import "time"
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Symbol is the requested symbol for which the quote is retrieved
+	Symbol string
+	// LatestTradingDay is the trading day to which the quote applies
+	LatestTradingDay time.Time
+}
+
+// Data is the returned object from a call to GetData
+type Data struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// Open is the opening price of the LatestTradingDay
+	Open float64
+	// High is the highest price of the LatestTradingDay
+	High float64
+	// Low is the lowest price of the LatestTradingDay
+	Low float64
+	// Price is the latest price
+	Price float64
+	// Volume is the traded volume of the LatestTradingDay
+	Volume int64
+	// PreviousClose is the closing price of the trading day before the LatestTradingDay
+	PreviousClose float64
+	// Change is Price less PreviousClose
+	Change float64
+	// ChangePercent is the Change as a percentage of the PreviousClose (e.g. 1.5 is 1.5%)
+	ChangePercent float64
+}
@@ -0,0 +1,106 @@
+package quote
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+// respJSON captures all possible return JSON
+type respJSON struct {
+	Info  *string            `json:"Information"`
+	Err   *string            `json:"Error Message"`
+	Quote *map[string]string `json:"Global Quote"`
+}
+
+// GetData uses the provided apiKey to retrieve the latest quote for the symbol, which is a single
+// small request in contrast to retrieving an intraday or daily series
+func GetData(symbol, apiKey string) (*Data, error) {
+
+	url, err := common.NewQuery("GLOBAL_QUOTE").Symbol(symbol).URL(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseJSON(b)
+}
+
+func parseJSON(b []byte) (*Data, error) {
+	var d respJSON
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	if d.Err != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Err, common.ErrRemoteCallError)
+	}
+	if d.Info != nil {
+		return nil, fmt.Errorf("api error: %s: %w", *d.Info, common.ErrRemoteCallError)
+	}
+
+	// Alpha Vantage returns an empty quote for unknown symbols
+	if d.Quote == nil || len(*d.Quote) == 0 {
+		return nil, fmt.Errorf("no quote available to be parsed: %w", common.ErrParseError)
+	}
+	q := *d.Quote
+
+	day, err := common.ParseDate(common.MetaValue(q, "latest trading day"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid latest trading day: %v: %w", err, common.ErrMetadataParseError)
+	}
+
+	result := &Data{
+		Meta: &Metadata{
+			Symbol:           common.MetaValue(q, "symbol"),
+			LatestTradingDay: day,
+		},
+	}
+
+	for _, v := range []struct {
+		name  string
+		value *float64
+	}{
+		{"open", &result.Open},
+		{"high", &result.High},
+		{"low", &result.Low},
+		{"price", &result.Price},
+		{"previous close", &result.PreviousClose},
+		{"change", &result.Change},
+	} {
+		s := common.MetaValue(q, ". "+v.name)
+		if *v.value, err = strconv.ParseFloat(s, 64); err != nil {
+			return nil, fmt.Errorf("invalid %s %s: %v: %w", v.name, s, err, common.ErrParseError)
+		}
+	}
+
+	s := common.MetaValue(q, "change percent")
+	if result.ChangePercent, err = strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64); err != nil {
+		return nil, fmt.Errorf("invalid change percent %s: %v: %w", s, err, common.ErrParseError)
+	}
+
+	s = common.MetaValue(q, "volume")
+	if result.Volume, err = strconv.ParseInt(s, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid volume %s: %v: %w", s, err, common.ErrParseError)
+	}
+
+	return result, nil
+}
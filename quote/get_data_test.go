@@ -0,0 +1,51 @@
+package quote
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+)
+
+func TestParseJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_quote.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	result, err := parseJSON(data)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Symbol != "IBM" || result.Meta.LatestTradingDay != time.Date(2025, 8, 22, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected metadata: %v", result.Meta)
+	}
+
+	if !common.EqualFloat64(241.5, result.Open, 4) || !common.EqualFloat64(245.3, result.High, 4) || !common.EqualFloat64(240.11, result.Low, 4) {
+		t.Fatalf("unexpected range: %v, %v, %v", result.Open, result.High, result.Low)
+	}
+
+	if !common.EqualFloat64(244.45, result.Price, 4) || !common.EqualFloat64(241.66, result.PreviousClose, 4) || result.Volume != 3804153 {
+		t.Fatalf("unexpected price: %v, %v, %v", result.Price, result.PreviousClose, result.Volume)
+	}
+
+	if !common.EqualFloat64(2.79, result.Change, 4) || !common.EqualFloat64(1.1545, result.ChangePercent, 4) {
+		t.Fatalf("unexpected change: %v, %v", result.Change, result.ChangePercent)
+	}
+}
+
+func TestParseJSON_1(t *testing.T) {
+
+	// Unknown symbols return an empty quote
+	if _, err := parseJSON([]byte(`{"Global Quote": {}}`)); !errors.Is(err, common.ErrParseError) {
+		t.Fatalf("expected ErrParseError, got %v", err)
+	}
+
+	if _, err := parseJSON([]byte(`{"Information": "rate limited"}`)); !errors.Is(err, common.ErrRemoteCallError) {
+		t.Fatalf("expected ErrRemoteCallError, got %v", err)
+	}
+}
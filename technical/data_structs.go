@@ -0,0 +1,76 @@
+package technical
+
+import (
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/historic"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+// Metadata describes what information was returned
+type Metadata struct {
+	// Symbol is the requested symbol for which data is retrieved
+	Symbol string
+	// Indicator is the requested indicator
+	Indicator Indicator
+	// Name is the description of the indicator provided by Alpha Vantage
+	Name string
+	// LastRefresh is the time the data itself was last updated
+	LastRefresh time.Time
+	// Frequency is the spacing of the elements in the time series
+	Frequency common.Frequency
+	// Interval is the spacing of the elements when Frequency is Intraday
+	Interval intraday.Interval
+	// TimeZone is the time zone of any returned datetime values
+	TimeZone string
+	// Parameters holds all of the returned metadata, keyed by name without the numeric prefix,
+	// e.g. "Time Period" or "Fast Period"
+	Parameters map[string]string
+	// DataRange describes the range of data that was returned
+	DataRange *DataRange
+}
+
+// Data Range describes the range of data history
+type DataRange struct {
+	// Start is the start time of the requested data range (earliest)
+	Start time.Time
+	// End is the end time of the requested data range (latest)
+	End time.Time
+}
+
+// Element is an entry in the TimeSeries
+type Element struct {
+	// Date is the time of the data in the element, based on the TZ in the metadata
+	Date time.Time
+	// Data holds the value of each Output of the indicator
+	Data map[Output]float64
+}
+
+// Data is the returned object from a call to GetData
+type Data struct {
+	// Meta describes the details of the data
+	Meta *Metadata
+	// Outputs lists the Outputs present in each Element, in name order
+	Outputs []Output
+	// TimeSeries is an ordered set of data
+	TimeSeries []*Element
+}
+
+// Series returns the values of the specified Output in the same form as the results
+// of historic.GetWindowedCalculation, so that provider values can be compared with
+// locally calculated WindowFunc results.
+func (d *Data) Series(output Output) []*historic.WindowedElement {
+	result := []*historic.WindowedElement{}
+	for _, e := range d.TimeSeries {
+		v, ok := e.Data[output]
+		if !ok {
+			continue
+		}
+		result = append(result, &historic.WindowedElement{
+			WindowStart: e.Date,
+			Value:       v,
+		})
+	}
+	return result
+}
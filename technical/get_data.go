@@ -0,0 +1,261 @@
+package technical
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+// ErrInvalidIndicator returned when an invalid indicator is specified
+var ErrInvalidIndicator = errors.New("invalid indicator specified")
+
+// ErrMissingParameter returned when a parameter required by the indicator has not been set
+var ErrMissingParameter = errors.New("missing parameter required by indicator")
+
+// GetData uses the provided apiKey to retrieve the server calculated indicator for the symbol
+func GetData(indicator Indicator, symbol, apiKey string, opts ...func(*Options) error) (*Data, error) {
+
+	o := defaultOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	url, err := buildURL(indicator, symbol, apiKey, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", resp.Status, common.ErrRemoteCallError)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrRemoteCallError)
+	}
+
+	return parseJSON(b, indicator, &o)
+}
+
+// buildURL validates the options against the requirements of the indicator and creates the request
+func buildURL(indicator Indicator, symbol, apiKey string, o *Options) (string, error) {
+
+	if !indicator.IsValid() {
+		return "", ErrInvalidIndicator
+	}
+	if len(symbol) == 0 {
+		return "", fmt.Errorf("symbol: %w", ErrMissingParameter)
+	}
+
+	interval := o.Frequency.String()
+	if o.Frequency == common.Intraday {
+		interval = o.Interval.String()
+	} else if indicator.intradayOnly() {
+		return "", fmt.Errorf("%s requires an intraday interval: %w", indicator, common.ErrInvalidInterval)
+	}
+
+	q := common.NewQuery(indicator.String()).
+		Symbol(symbol).
+		Set("interval", interval)
+
+	if indicator.needsTimePeriod() {
+		if o.TimePeriod == 0 {
+			return "", fmt.Errorf("time_period: %w", ErrMissingParameter)
+		}
+		q.SetInt("time_period", o.TimePeriod)
+	}
+
+	if indicator.needsSeriesType() {
+		q.Set("series_type", o.SeriesType.String())
+	}
+
+	if o.Month != "" && o.Frequency == common.Intraday {
+		q.Set("month", o.Month)
+	}
+
+	for k, v := range o.Parameters {
+		q.Set(k, v)
+	}
+
+	return q.URL(apiKey)
+}
+
+func parseJSON(b []byte, indicator Indicator, o *Options) (*Data, error) {
+	var d map[string]json.RawMessage
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+	for _, k := range []string{"Error Message", "Information"} {
+		if v, ok := d[k]; ok {
+			var msg string
+			_ = json.Unmarshal(v, &msg)
+			return nil, fmt.Errorf("api error: %s: %w", msg, common.ErrRemoteCallError)
+		}
+	}
+
+	result := &Data{
+		Meta:       &Metadata{},
+		Outputs:    []Output{},
+		TimeSeries: []*Element{},
+	}
+
+	if err := parseMetadata(d["Meta Data"], result, indicator, o); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrMetadataParseError)
+	}
+
+	// The time series key is "Technical Analysis: " followed by the indicator name
+	var ts json.RawMessage
+	for k, v := range d {
+		if strings.HasPrefix(k, "Technical Analysis") {
+			ts = v
+			break
+		}
+	}
+
+	if err := parseTimeSeries(ts, result); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrTimeSeriesParseError)
+	}
+
+	return result, nil
+}
+
+func parseMetadata(b json.RawMessage, r *Data, indicator Indicator, o *Options) error {
+	if b == nil {
+		return errors.New("no metadata available to be parsed")
+	}
+
+	// Metadata values are a mix of strings and numbers, with keys such as "5: Time Period"
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	params := map[string]string{}
+	for k, v := range m {
+		if _, name, ok := strings.Cut(k, ": "); ok {
+			k = name
+		}
+		params[k] = fmt.Sprint(v)
+	}
+
+	im := &Metadata{
+		Symbol:     params["Symbol"],
+		Indicator:  indicator,
+		Name:       params["Indicator"],
+		Frequency:  o.Frequency,
+		TimeZone:   params["Time Zone"],
+		Parameters: params,
+	}
+
+	var err error
+	if im.Frequency == common.Intraday {
+		im.Interval, err = intraday.ParseInterval(params["Interval"])
+		if err != nil {
+			return err
+		}
+	}
+
+	im.LastRefresh, err = parseTimestamp(params["Last Refreshed"])
+	if err != nil {
+		return err
+	}
+
+	r.Meta = im
+	return nil
+}
+
+// parseTimestamp accepts dates, and intraday times either with or without seconds
+func parseTimestamp(s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02 15:04", s); err == nil {
+		return t, nil
+	}
+	return common.ParseDateOrIntradayDate(s)
+}
+
+var earliestDate = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+var latestDate = time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)
+
+func parseTimeSeries(b json.RawMessage, r *Data) error {
+
+	if b == nil {
+		return errors.New("no data available to be parsed")
+	}
+
+	var tmDataMap map[string]map[string]string
+	if err := json.Unmarshal(b, &tmDataMap); err != nil {
+		return fmt.Errorf("provided time series is of the wrong type: %v", err)
+	}
+
+	tm := []*Element{}
+	outputs := map[Output]bool{}
+
+	dtRng := &DataRange{
+		Start: latestDate,
+		End:   earliestDate,
+	}
+
+	for k, m := range tmDataMap {
+		if m == nil {
+			return fmt.Errorf("v is nil for %s", k)
+		}
+
+		ele := &Element{
+			Data: map[Output]float64{},
+		}
+
+		t, err := parseTimestamp(k)
+		if err != nil {
+			return err
+		}
+		ele.Date = t
+
+		if t.Before(dtRng.Start) {
+			dtRng.Start = t
+		}
+		if t.After(dtRng.End) {
+			dtRng.End = t
+		}
+
+		for name, s := range m {
+			value, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return fmt.Errorf("error parsing %s (%s) for %s: %v", s, name, k, err)
+			}
+			ele.Data[Output(name)] = value
+			outputs[Output(name)] = true
+		}
+
+		tm = append(tm, ele)
+	}
+
+	// Sort is descending ... most recent date first
+	slices.SortFunc(tm, func(a, b *Element) int {
+		return b.Date.Compare(a.Date)
+	})
+
+	for output := range outputs {
+		r.Outputs = append(r.Outputs, output)
+	}
+	slices.Sort(r.Outputs)
+
+	r.TimeSeries = tm
+	r.Meta.DataRange = dtRng
+	return nil
+}
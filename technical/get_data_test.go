@@ -0,0 +1,174 @@
+package technical
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+func TestParseJSON(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_sma_daily.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	o := defaultOptions
+
+	result, err := parseJSON(data, SMA, &o)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Symbol != "IBM" {
+		t.Fatalf("unexpected symbol: %s", result.Meta.Symbol)
+	}
+
+	if result.Meta.Parameters["Time Period"] != "10" {
+		t.Fatalf("unexpected time period: %s", result.Meta.Parameters["Time Period"])
+	}
+
+	if result.Meta.LastRefresh != time.Date(2025, 8, 22, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected last refresh: %v", result.Meta.LastRefresh)
+	}
+
+	if len(result.Outputs) != 1 || result.Outputs[0] != SingleOutput(SMA) {
+		t.Fatalf("unexpected outputs: %v", result.Outputs)
+	}
+
+	if len(result.TimeSeries) != 5 {
+		t.Fatalf("expected 5 elements, got %d", len(result.TimeSeries))
+	}
+
+	if result.Meta.DataRange.Start != time.Date(2025, 8, 18, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected data range start: %v", result.Meta.DataRange.Start)
+	}
+
+	series := result.Series(SingleOutput(SMA))
+	if len(series) != 5 {
+		t.Fatalf("expected 5 windowed elements, got %d", len(series))
+	}
+
+	if !common.EqualFloat64(240.678, series[0].Value, 3) {
+		t.Fatalf("unexpected first value: %v", series[0].Value)
+	}
+}
+
+func TestParseJSON_1(t *testing.T) {
+
+	data, err := os.ReadFile("../example_data/ibm_macd_intraday.json")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+
+	o := defaultOptions
+	if err := WithInterval(intraday.FiveMin)(&o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := parseJSON(data, MACD, &o)
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if result.Meta.Interval != intraday.FiveMin {
+		t.Fatalf("unexpected interval: %v", result.Meta.Interval)
+	}
+
+	if result.Meta.Parameters["Signal Period"] != "9" {
+		t.Fatalf("unexpected signal period: %s", result.Meta.Parameters["Signal Period"])
+	}
+
+	if len(result.Outputs) != 3 {
+		t.Fatalf("expected 3 outputs, got %v", result.Outputs)
+	}
+
+	if result.TimeSeries[0].Date != time.Date(2025, 8, 22, 19, 55, 0, 0, time.UTC) {
+		t.Fatalf("unexpected first date: %v", result.TimeSeries[0].Date)
+	}
+
+	if !common.EqualFloat64(0.0586, result.TimeSeries[1].Data[MACDSignal], 4) {
+		t.Fatalf("unexpected signal value: %v", result.TimeSeries[1].Data[MACDSignal])
+	}
+}
+
+func TestBuildURL(t *testing.T) {
+
+	o := defaultOptions
+	for _, opt := range []func(*Options) error{
+		WithTimePeriod(10),
+		WithSeriesType(Open),
+	} {
+		if err := opt(&o); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	url, err := buildURL(SMA, "ibm", "KEY", &o)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "https://www.alphavantage.co/query?apikey=KEY&function=SMA&interval=daily&series_type=open&symbol=IBM&time_period=10"
+	if url != expected {
+		t.Fatalf("unexpected url: expected %s, got %s", expected, url)
+	}
+
+	o = defaultOptions
+	if err := WithParameter("fastperiod", "6")(&o); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	url, err = buildURL(MACD, "IBM", "KEY", &o)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected = "https://www.alphavantage.co/query?apikey=KEY&fastperiod=6&function=MACD&interval=daily&series_type=close&symbol=IBM"
+	if url != expected {
+		t.Fatalf("unexpected url: expected %s, got %s", expected, url)
+	}
+}
+
+func TestGetData(t *testing.T) {
+
+	// Invalid requests are rejected before any remote call is made
+	if _, err := GetData(SMA, "IBM", "KEY"); !errors.Is(err, ErrMissingParameter) {
+		t.Fatalf("unexpected error: expected %v, got %v", ErrMissingParameter, err)
+	}
+
+	if _, err := GetData(VWAP, "IBM", "KEY"); !errors.Is(err, common.ErrInvalidInterval) {
+		t.Fatalf("unexpected error: expected %v, got %v", common.ErrInvalidInterval, err)
+	}
+
+	if _, err := GetData(InvalidIndicator, "IBM", "KEY"); err != ErrInvalidIndicator {
+		t.Fatalf("unexpected error: expected %v, got %v", ErrInvalidIndicator, err)
+	}
+
+	if _, err := GetData(MACD, "IBM", "KEY", WithParameter("apikey", "X")); err == nil {
+		t.Fatal("expected error for reserved parameter, got nil")
+	}
+}
+
+func TestSingleOutput(t *testing.T) {
+
+	if o := SingleOutput(RSI); o != "RSI" {
+		t.Fatalf("unexpected output: %s", o)
+	}
+	if o := SingleOutput(AD); o != "Chaikin A/D" {
+		t.Fatalf("unexpected output: %s", o)
+	}
+}
+
+func TestIndicator(t *testing.T) {
+
+	for i := UnknownIndicator + 1; i < InvalidIndicator; i++ {
+		if i.String() == "" {
+			t.Fatalf("missing description for %d", i)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package technical
+
+type Indicator int
+
+const (
+	UnknownIndicator Indicator = iota
+	SMA
+	EMA
+	WMA
+	DEMA
+	TEMA
+	TRIMA
+	KAMA
+	MAMA
+	VWAP
+	T3
+	MACD
+	MACDEXT
+	STOCH
+	STOCHF
+	RSI
+	STOCHRSI
+	WILLR
+	ADX
+	ADXR
+	APO
+	PPO
+	MOM
+	BOP
+	CCI
+	CMO
+	ROC
+	ROCR
+	AROON
+	AROONOSC
+	MFI
+	TRIX
+	ULTOSC
+	DX
+	MinusDI
+	PlusDI
+	MinusDM
+	PlusDM
+	BBANDS
+	MIDPOINT
+	MIDPRICE
+	SAR
+	TRANGE
+	ATR
+	NATR
+	AD
+	ADOSC
+	OBV
+	HTTrendline
+	HTSine
+	HTTrendMode
+	HTDCPeriod
+	HTDCPhase
+	HTPhasor
+	InvalidIndicator
+)
+
+// String returns the Alpha Vantage function name of the indicator
+func (i Indicator) String() string {
+	switch i {
+	case SMA:
+		return "SMA"
+	case EMA:
+		return "EMA"
+	case WMA:
+		return "WMA"
+	case DEMA:
+		return "DEMA"
+	case TEMA:
+		return "TEMA"
+	case TRIMA:
+		return "TRIMA"
+	case KAMA:
+		return "KAMA"
+	case MAMA:
+		return "MAMA"
+	case VWAP:
+		return "VWAP"
+	case T3:
+		return "T3"
+	case MACD:
+		return "MACD"
+	case MACDEXT:
+		return "MACDEXT"
+	case STOCH:
+		return "STOCH"
+	case STOCHF:
+		return "STOCHF"
+	case RSI:
+		return "RSI"
+	case STOCHRSI:
+		return "STOCHRSI"
+	case WILLR:
+		return "WILLR"
+	case ADX:
+		return "ADX"
+	case ADXR:
+		return "ADXR"
+	case APO:
+		return "APO"
+	case PPO:
+		return "PPO"
+	case MOM:
+		return "MOM"
+	case BOP:
+		return "BOP"
+	case CCI:
+		return "CCI"
+	case CMO:
+		return "CMO"
+	case ROC:
+		return "ROC"
+	case ROCR:
+		return "ROCR"
+	case AROON:
+		return "AROON"
+	case AROONOSC:
+		return "AROONOSC"
+	case MFI:
+		return "MFI"
+	case TRIX:
+		return "TRIX"
+	case ULTOSC:
+		return "ULTOSC"
+	case DX:
+		return "DX"
+	case MinusDI:
+		return "MINUS_DI"
+	case PlusDI:
+		return "PLUS_DI"
+	case MinusDM:
+		return "MINUS_DM"
+	case PlusDM:
+		return "PLUS_DM"
+	case BBANDS:
+		return "BBANDS"
+	case MIDPOINT:
+		return "MIDPOINT"
+	case MIDPRICE:
+		return "MIDPRICE"
+	case SAR:
+		return "SAR"
+	case TRANGE:
+		return "TRANGE"
+	case ATR:
+		return "ATR"
+	case NATR:
+		return "NATR"
+	case AD:
+		return "AD"
+	case ADOSC:
+		return "ADOSC"
+	case OBV:
+		return "OBV"
+	case HTTrendline:
+		return "HT_TRENDLINE"
+	case HTSine:
+		return "HT_SINE"
+	case HTTrendMode:
+		return "HT_TRENDMODE"
+	case HTDCPeriod:
+		return "HT_DCPERIOD"
+	case HTDCPhase:
+		return "HT_DCPHASE"
+	case HTPhasor:
+		return "HT_PHASOR"
+	default:
+		panic("invalid value of Indicator")
+	}
+}
+
+// IsValid returns true if the Indicator can be used in a request
+func (i Indicator) IsValid() bool {
+	if i <= UnknownIndicator || i >= InvalidIndicator {
+		return false
+	}
+	return true
+}
+
+// needsTimePeriod returns true if the indicator requires the time_period parameter
+func (i Indicator) needsTimePeriod() bool {
+	switch i {
+	case SMA, EMA, WMA, DEMA, TEMA, TRIMA, KAMA, T3, RSI, MOM, CMO, ROC, ROCR, TRIX, MIDPOINT, BBANDS, STOCHRSI, WILLR, ADX, ADXR, CCI, AROON, AROONOSC, MFI, DX, MinusDI, PlusDI, MinusDM, PlusDM, MIDPRICE, ATR, NATR:
+		return true
+	default:
+		return false
+	}
+}
+
+// needsSeriesType returns true if the indicator requires the series_type parameter
+func (i Indicator) needsSeriesType() bool {
+	switch i {
+	case SMA, EMA, WMA, DEMA, TEMA, TRIMA, KAMA, T3, RSI, MOM, CMO, ROC, ROCR, TRIX, MIDPOINT, BBANDS, STOCHRSI, MAMA, MACD, MACDEXT, APO, PPO, HTTrendline, HTSine, HTTrendMode, HTDCPeriod, HTDCPhase, HTPhasor:
+		return true
+	default:
+		return false
+	}
+}
+
+// intradayOnly returns true if the indicator is only available for intraday intervals
+func (i Indicator) intradayOnly() bool {
+	return i == VWAP
+}
@@ -0,0 +1,124 @@
+package technical
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/intraday"
+)
+
+// Options builds the request made by GetData.
+// Each indicator requires a different combination of parameters, which are validated when the request is made.
+type Options struct {
+	// Frequency specifies the interval between time series elements.  Default: daily
+	Frequency common.Frequency
+	// Interval specifies the interval between elements when Frequency is Intraday
+	Interval intraday.Interval
+	// TimePeriod is the number of data points used to calculate each value, for indicators that require it
+	TimePeriod int
+	// SeriesType is the price used in the calculation, for indicators that require it.  Default: close
+	SeriesType SeriesType
+	// Month, if set, selects a historic month (YYYY-MM) of intraday data
+	Month string
+	// Parameters holds any indicator specific parameters, e.g. fastperiod for MACD
+	Parameters map[string]string
+}
+
+// WithFrequency sets the Daily, Weekly or Monthly interval between elements
+func WithFrequency(frequency common.Frequency) func(*Options) error {
+	return func(o *Options) error {
+		switch frequency {
+		case common.Daily, common.Weekly, common.Monthly:
+			o.Frequency = frequency
+			return nil
+		default:
+			return common.ErrInvalidInterval
+		}
+	}
+}
+
+// WithInterval sets an intraday interval between elements
+func WithInterval(interval intraday.Interval) func(*Options) error {
+	return func(o *Options) error {
+		if !interval.IsValid() {
+			return common.ErrInvalidInterval
+		}
+		o.Frequency = common.Intraday
+		o.Interval = interval
+		return nil
+	}
+}
+
+// WithTimePeriod sets the number of data points used to calculate each value
+func WithTimePeriod(n int) func(*Options) error {
+	return func(o *Options) error {
+		if n < 1 {
+			return fmt.Errorf("invalid time period: %d", n)
+		}
+		o.TimePeriod = n
+		return nil
+	}
+}
+
+// WithSeriesType sets the price used in the calculation
+func WithSeriesType(seriesType SeriesType) func(*Options) error {
+	return func(o *Options) error {
+		if !seriesType.isValid() {
+			return errors.New("invalid series type")
+		}
+		o.SeriesType = seriesType
+		return nil
+	}
+}
+
+// WithMonth selects a historic month of intraday data.  This can be any month after 2000-01.
+func WithMonth(year, month int) func(*Options) error {
+	return func(o *Options) error {
+		if year > time.Now().Year() || year < 2000 {
+			return fmt.Errorf("invalid year specified: %d", year)
+		}
+		if month < 1 || month > 12 {
+			return fmt.Errorf("invalid month specified: %d", month)
+		}
+		o.Month = fmt.Sprintf("%d-%02d", year, month)
+		return nil
+	}
+}
+
+// WithParameter sets an indicator specific parameter, such as fastperiod, slowperiod or signalperiod for MACD
+func WithParameter(name, value string) func(*Options) error {
+	return func(o *Options) error {
+		if _, ok := reservedParameters[name]; ok {
+			return fmt.Errorf("parameter %s must be set using its own option", name)
+		}
+		if len(name) == 0 || len(value) == 0 {
+			return errors.New("parameter name and value must be specified")
+		}
+		params := map[string]string{}
+		for k, v := range o.Parameters {
+			params[k] = v
+		}
+		params[name] = value
+		o.Parameters = params
+		return nil
+	}
+}
+
+// reservedParameters are set by GetData or by the other options
+var reservedParameters = map[string]struct{}{
+	"function":    {},
+	"symbol":      {},
+	"interval":    {},
+	"time_period": {},
+	"series_type": {},
+	"month":       {},
+	"apikey":      {},
+	"datatype":    {},
+}
+
+var defaultOptions = Options{
+	Frequency:  common.Daily,
+	SeriesType: Close,
+}
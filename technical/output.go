@@ -0,0 +1,55 @@
+package technical
+
+// Output is the name of a single value returned by an indicator.
+// Most single output indicators use the indicator name (e.g. "SMA"), with exceptions
+// provided as constants and by SingleOutput; the names of the values of multiple
+// output indicators are provided as constants.
+type Output string
+
+const (
+	// MACD and MACDEXT outputs
+	MACDLine      Output = "MACD"
+	MACDSignal    Output = "MACD_Signal"
+	MACDHistogram Output = "MACD_Hist"
+	// BBANDS outputs
+	UpperBand  Output = "Real Upper Band"
+	MiddleBand Output = "Real Middle Band"
+	LowerBand  Output = "Real Lower Band"
+	// STOCH outputs
+	SlowK Output = "SlowK"
+	SlowD Output = "SlowD"
+	// STOCHF and STOCHRSI outputs
+	FastK Output = "FastK"
+	FastD Output = "FastD"
+	// AROON outputs
+	AroonUp   Output = "Aroon Up"
+	AroonDown Output = "Aroon Down"
+	// MAMA outputs
+	MAMALine Output = "MAMA"
+	FAMALine Output = "FAMA"
+	// HT_SINE outputs
+	Sine     Output = "SINE"
+	LeadSine Output = "LEAD SINE"
+	// HT_PHASOR outputs
+	Phase      Output = "PHASE"
+	Quadrature Output = "QUADRATURE"
+	// Single outputs that are not named after their indicator
+	ChaikinAD Output = "Chaikin A/D"
+	TrendMode Output = "TRENDMODE"
+	DCPeriod  Output = "DCPERIOD"
+)
+
+// SingleOutput returns the Output of an indicator that returns a single value per element,
+// which is the indicator name except for AD, HT_TRENDMODE and HT_DCPERIOD
+func SingleOutput(i Indicator) Output {
+	switch i {
+	case AD:
+		return ChaikinAD
+	case HTTrendMode:
+		return TrendMode
+	case HTDCPeriod:
+		return DCPeriod
+	default:
+		return Output(i.String())
+	}
+}
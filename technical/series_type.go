@@ -0,0 +1,34 @@
+package technical
+
+type SeriesType int
+
+const (
+	UnknownSeriesType SeriesType = iota
+	Close
+	Open
+	High
+	Low
+	InvalidSeriesType
+)
+
+func (s SeriesType) String() string {
+	switch s {
+	case Close:
+		return "close"
+	case Open:
+		return "open"
+	case High:
+		return "high"
+	case Low:
+		return "low"
+	default:
+		panic("invalid value of SeriesType")
+	}
+}
+
+func (s SeriesType) isValid() bool {
+	if s <= UnknownSeriesType || s >= InvalidSeriesType {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,231 @@
+package universe
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/listing"
+)
+
+// ErrNoUniverse returned when a Universe is not found in a Store
+var ErrNoUniverse = errors.New("universe not found")
+
+type membershipJSON struct {
+	Symbol  string `json:"symbol"`
+	Added   string `json:"added"`
+	Removed string `json:"removed,omitempty"`
+}
+
+type universeJSON struct {
+	Name        string            `json:"name"`
+	Memberships []*membershipJSON `json:"memberships"`
+}
+
+// Save writes the Universe, including its membership history, as JSON
+func (u *Universe) Save(w io.Writer) error {
+
+	uj := universeJSON{
+		Name:        u.Name,
+		Memberships: make([]*membershipJSON, 0, len(u.Memberships)),
+	}
+	for _, m := range u.Memberships {
+		mj := &membershipJSON{
+			Symbol: string(m.Symbol),
+			Added:  m.Added.Format(time.DateOnly),
+		}
+		if !m.Removed.IsZero() {
+			mj.Removed = m.Removed.Format(time.DateOnly)
+		}
+		uj.Memberships = append(uj.Memberships, mj)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(&uj)
+}
+
+// Load reads a Universe written by Save
+func Load(r io.Reader) (*Universe, error) {
+
+	var uj universeJSON
+	if err := json.NewDecoder(r).Decode(&uj); err != nil {
+		return nil, fmt.Errorf("%v: %w", err, common.ErrParseError)
+	}
+
+	u, err := New(uj.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, mj := range uj.Memberships {
+		if len(mj.Symbol) == 0 {
+			return nil, fmt.Errorf("membership %d: empty symbol: %w", i, common.ErrParseError)
+		}
+		added, err := common.ParseDate(mj.Added)
+		if err != nil {
+			return nil, fmt.Errorf("membership %d: added error parsing '%s': %v: %w", i, mj.Added, err, common.ErrParseError)
+		}
+		var removed time.Time
+		if len(mj.Removed) > 0 {
+			removed, err = common.ParseDate(mj.Removed)
+			if err != nil {
+				return nil, fmt.Errorf("membership %d: removed error parsing '%s': %v: %w", i, mj.Removed, err, common.ErrParseError)
+			}
+			if removed.Before(added) {
+				return nil, fmt.Errorf("membership %d: %s removed before added: %w", i, mj.Symbol, common.ErrParseError)
+			}
+		}
+		u.Memberships = append(u.Memberships, &Membership{
+			Symbol:  listing.Symbol(strings.ToUpper(mj.Symbol)),
+			Added:   added,
+			Removed: removed,
+		})
+	}
+
+	u.sort()
+
+	// Periods of membership of a symbol cannot overlap
+	for i := 1; i < len(u.Memberships); i++ {
+		prev, m := u.Memberships[i-1], u.Memberships[i]
+		if prev.Symbol == m.Symbol && (prev.Removed.IsZero() || m.Added.Before(prev.Removed)) {
+			return nil, fmt.Errorf("%s has overlapping memberships: %w", m.Symbol, common.ErrParseError)
+		}
+	}
+
+	return u, nil
+}
+
+// LoadSymbols reads a Universe from a plain text file of symbols, one per line, which become members
+// from the specified date.  Blank lines, and anything following a '#', are ignored.
+func LoadSymbols(name string, date time.Time, r io.Reader) (*Universe, error) {
+
+	symbols := []listing.Symbol{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		symbols = append(symbols, listing.Symbol(line))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return FromSymbols(name, date, symbols...)
+}
+
+const storeSuffix = ".json"
+
+// Store persists Universes as files within a directory, one per Universe
+type Store struct {
+	dir string
+}
+
+// NewStore creates a Store using the directory, which must exist
+func NewStore(dir string) (*Store, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	return &Store{dir: dir}, nil
+}
+
+func (s *Store) path(name string) string {
+	return filepath.Join(s.dir, name+storeSuffix)
+}
+
+// Save writes the Universe to the Store, replacing any existing Universe of the same name
+func (s *Store) Save(u *Universe) error {
+
+	if !isValidName(u.Name) {
+		return fmt.Errorf("'%s': %w", u.Name, ErrInvalidName)
+	}
+
+	// Write to a temporary file first, so that an existing Universe is not lost on failure
+	f, err := os.CreateTemp(s.dir, u.Name+"*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := u.Save(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), s.path(u.Name))
+}
+
+// Load reads the named Universe from the Store
+func (s *Store) Load(name string) (*Universe, error) {
+
+	if !isValidName(name) {
+		return nil, fmt.Errorf("'%s': %w", name, ErrInvalidName)
+	}
+
+	f, err := os.Open(s.path(name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%s: %w", name, ErrNoUniverse)
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+// Delete removes the named Universe from the Store
+func (s *Store) Delete(name string) error {
+
+	if !isValidName(name) {
+		return fmt.Errorf("'%s': %w", name, ErrInvalidName)
+	}
+
+	if err := os.Remove(s.path(name)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%s: %w", name, ErrNoUniverse)
+		}
+		return err
+	}
+	return nil
+}
+
+// Names returns the names of the Universes in the Store, in ascending order
+func (s *Store) Names() ([]string, error) {
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		if name, ok := strings.CutSuffix(e.Name(), storeSuffix); ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
@@ -0,0 +1,115 @@
+package universe
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gford1000-go/alphav/common"
+	"github.com/gford1000-go/alphav/listing"
+)
+
+func TestSaveLoad(t *testing.T) {
+
+	u, err := FromSymbols("tech", date(2020, 1, 1), "AAPL", "IBM")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := u.Remove(date(2021, 1, 1), "IBM"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := u.Add(date(2022, 1, 1), "IBM"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := u.Save(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	loaded, err := Load(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if loaded.Name != u.Name || len(loaded.Memberships) != len(u.Memberships) {
+		t.Fatalf("unexpected universe: %v", loaded)
+	}
+	for _, d := range []int{2020, 2021, 2022} {
+		if !slices.Equal(loaded.MembersOn(date(d, 6, 1)), u.MembersOn(date(d, 6, 1))) {
+			t.Fatalf("%d: members differ", d)
+		}
+	}
+}
+
+func TestLoadOverlapping(t *testing.T) {
+
+	data := `{"name":"bad","memberships":[
+		{"symbol":"IBM","added":"2020-01-01","removed":"2021-01-01"},
+		{"symbol":"IBM","added":"2020-06-01"}]}`
+
+	if _, err := Load(strings.NewReader(data)); !errors.Is(err, common.ErrParseError) {
+		t.Fatalf("expected ErrParseError, got %v", err)
+	}
+}
+
+func TestLoadSymbols(t *testing.T) {
+
+	data := `# My watchlist
+aapl
+  MSFT   # software
+
+BRK.B
+`
+	u, err := LoadSymbols("watch", date(2024, 1, 1), strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := u.Members(); !slices.Equal(got, []listing.Symbol{"AAPL", "BRK.B", "MSFT"}) {
+		t.Fatalf("unexpected members: %v", got)
+	}
+}
+
+func TestStore(t *testing.T) {
+
+	s, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, name := range []string{"tech", "banks"} {
+		u, err := FromSymbols(name, date(2024, 1, 1), "IBM")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := s.Save(u); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	names, err := s.Names()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(names, []string{"banks", "tech"}) {
+		t.Fatalf("unexpected names: %v", names)
+	}
+
+	u, err := s.Load("tech")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !u.Contains("IBM", date(2024, 2, 1)) {
+		t.Fatal("expected IBM to be a member")
+	}
+
+	if err := s.Delete("tech"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.Load("tech"); !errors.Is(err, ErrNoUniverse) {
+		t.Fatalf("expected ErrNoUniverse, got %v", err)
+	}
+}
@@ -0,0 +1,257 @@
+package universe
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gford1000-go/alphav/listing"
+)
+
+// ErrInvalidName returned when a Universe name is empty or cannot be used as a file name
+var ErrInvalidName = errors.New("invalid universe name")
+
+// ErrOutOfOrder returned when a membership change is dated before an earlier change to the same symbol
+var ErrOutOfOrder = errors.New("membership change is before an existing change")
+
+// Membership is a period during which a symbol was a member of a Universe
+type Membership struct {
+	// Symbol is the member
+	Symbol listing.Symbol
+	// Added is the date from which the symbol was a member
+	Added time.Time
+	// Removed is the date from which the symbol was no longer a member, which is zero if it is still a member
+	Removed time.Time
+}
+
+// activeOn returns true if the symbol was a member on the date
+func (m *Membership) activeOn(date time.Time) bool {
+	return !m.Added.After(date) && (m.Removed.IsZero() || date.Before(m.Removed))
+}
+
+// Universe is a named set of symbols, such as a watchlist, whose membership is versioned
+// so that the members on any date can be reconstructed
+type Universe struct {
+	// Name identifies the Universe
+	Name string
+	// Memberships records every period of membership, ordered by symbol and then date added
+	Memberships []*Membership
+}
+
+// New creates an empty Universe
+func New(name string) (*Universe, error) {
+	if !isValidName(name) {
+		return nil, fmt.Errorf("'%s': %w", name, ErrInvalidName)
+	}
+	return &Universe{
+		Name:        name,
+		Memberships: []*Membership{},
+	}, nil
+}
+
+// FromSymbols creates a Universe whose members are the symbols from the specified date
+func FromSymbols(name string, date time.Time, symbols ...listing.Symbol) (*Universe, error) {
+	u, err := New(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.Add(date, symbols...); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// FromQuery creates a Universe whose members are the results of the listing Query from the specified date
+func FromQuery(name string, date time.Time, q *listing.Query) (*Universe, error) {
+	u, err := New(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.SetFromQuery(date, q); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func isValidName(name string) bool {
+	return len(name) > 0 && !strings.ContainsAny(name, `/\:*?"<>|`) && name != "." && name != ".."
+}
+
+// latest returns the most recent Membership of the symbol, or nil if it has never been a member
+func (u *Universe) latest(symbol listing.Symbol) *Membership {
+	var result *Membership
+	for _, m := range u.Memberships {
+		if m.Symbol == symbol && (result == nil || m.Added.After(result.Added)) {
+			result = m
+		}
+	}
+	return result
+}
+
+// lastChange returns the date of the most recent change to the membership of the symbol
+func lastChange(m *Membership) time.Time {
+	if m.Removed.After(m.Added) {
+		return m.Removed
+	}
+	return m.Added
+}
+
+// update applies the changes to a copy of the Memberships, which only replaces the original
+// if the changes succeed, so that a failed change leaves the Universe unaltered
+func (u *Universe) update(change func(c *Universe) error) error {
+	c := &Universe{
+		Name:        u.Name,
+		Memberships: make([]*Membership, 0, len(u.Memberships)),
+	}
+	for _, m := range u.Memberships {
+		copied := *m
+		c.Memberships = append(c.Memberships, &copied)
+	}
+
+	if err := change(c); err != nil {
+		return err
+	}
+
+	u.Memberships = c.Memberships
+	return nil
+}
+
+// Add makes the symbols members from the specified date.  Symbols that are already members are unchanged.
+// If any symbol cannot be added, the Universe is unchanged.
+func (u *Universe) Add(date time.Time, symbols ...listing.Symbol) error {
+	return u.update(func(c *Universe) error {
+		return c.add(date, symbols...)
+	})
+}
+
+func (u *Universe) add(date time.Time, symbols ...listing.Symbol) error {
+	for _, s := range symbols {
+		symbol := listing.Symbol(strings.ToUpper(string(s)))
+		if len(symbol) == 0 {
+			return errors.New("empty symbol specified")
+		}
+
+		m := u.latest(symbol)
+		if m != nil && date.Before(lastChange(m)) {
+			return fmt.Errorf("%s on %s: %w", symbol, date.Format(time.DateOnly), ErrOutOfOrder)
+		}
+		if m != nil && m.Removed.IsZero() {
+			continue // Already a member
+		}
+
+		u.Memberships = append(u.Memberships, &Membership{
+			Symbol: symbol,
+			Added:  date,
+		})
+	}
+
+	u.sort()
+	return nil
+}
+
+// Remove ends the membership of the symbols from the specified date.  Symbols that are not members are ignored.
+// If any symbol cannot be removed, the Universe is unchanged.
+func (u *Universe) Remove(date time.Time, symbols ...listing.Symbol) error {
+	return u.update(func(c *Universe) error {
+		return c.remove(date, symbols...)
+	})
+}
+
+func (u *Universe) remove(date time.Time, symbols ...listing.Symbol) error {
+	for _, s := range symbols {
+		symbol := listing.Symbol(strings.ToUpper(string(s)))
+
+		m := u.latest(symbol)
+		if m == nil || !m.Removed.IsZero() {
+			continue // Not a member
+		}
+		if date.Before(m.Added) {
+			return fmt.Errorf("%s on %s: %w", symbol, date.Format(time.DateOnly), ErrOutOfOrder)
+		}
+		m.Removed = date
+	}
+	return nil
+}
+
+// Set changes the membership from the specified date to be exactly the symbols,
+// adding those that are not members and removing members that are not included.
+// If any symbol cannot be changed, the Universe is unchanged.
+func (u *Universe) Set(date time.Time, symbols ...listing.Symbol) error {
+	keep := map[listing.Symbol]bool{}
+	for _, s := range symbols {
+		keep[listing.Symbol(strings.ToUpper(string(s)))] = true
+	}
+
+	remove := []listing.Symbol{}
+	for _, symbol := range u.Members() {
+		if !keep[symbol] {
+			remove = append(remove, symbol)
+		}
+	}
+
+	return u.update(func(c *Universe) error {
+		if err := c.remove(date, remove...); err != nil {
+			return err
+		}
+		return c.add(date, symbols...)
+	})
+}
+
+// SetFromQuery changes the membership from the specified date to be exactly the results of the listing Query
+func (u *Universe) SetFromQuery(date time.Time, q *listing.Query) error {
+	infos, err := q.Results()
+	if err != nil {
+		return err
+	}
+	symbols := make([]listing.Symbol, 0, len(infos))
+	for _, info := range infos {
+		symbols = append(symbols, info.Symbol)
+	}
+	return u.Set(date, symbols...)
+}
+
+// Members returns the current members, ordered by symbol
+func (u *Universe) Members() []listing.Symbol {
+	result := []listing.Symbol{}
+	for _, m := range u.Memberships {
+		if m.Removed.IsZero() {
+			result = append(result, m.Symbol)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// MembersOn returns the members on the specified date, ordered by symbol
+func (u *Universe) MembersOn(date time.Time) []listing.Symbol {
+	result := []listing.Symbol{}
+	for _, m := range u.Memberships {
+		if m.activeOn(date) {
+			result = append(result, m.Symbol)
+		}
+	}
+	slices.Sort(result)
+	return slices.Compact(result)
+}
+
+// Contains returns true if the symbol was a member on the specified date
+func (u *Universe) Contains(symbol listing.Symbol, date time.Time) bool {
+	symbol = listing.Symbol(strings.ToUpper(string(symbol)))
+	for _, m := range u.Memberships {
+		if m.Symbol == symbol && m.activeOn(date) {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *Universe) sort() {
+	slices.SortFunc(u.Memberships, func(a, b *Membership) int {
+		if n := strings.Compare(string(a.Symbol), string(b.Symbol)); n != 0 {
+			return n
+		}
+		return a.Added.Compare(b.Added)
+	})
+}
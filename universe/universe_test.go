@@ -0,0 +1,164 @@
+package universe
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gford1000-go/alphav/listing"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestMembersOn(t *testing.T) {
+
+	u, err := FromSymbols("tech", date(2020, 1, 1), "AAPL", "msft", "IBM")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := u.Remove(date(2021, 6, 1), "IBM"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := u.Add(date(2022, 1, 1), "NVDA", "IBM"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		date     time.Time
+		expected []listing.Symbol
+	}{
+		{date(2019, 12, 31), []listing.Symbol{}},
+		{date(2020, 1, 1), []listing.Symbol{"AAPL", "IBM", "MSFT"}},
+		{date(2021, 5, 31), []listing.Symbol{"AAPL", "IBM", "MSFT"}},
+		{date(2021, 6, 1), []listing.Symbol{"AAPL", "MSFT"}},
+		{date(2022, 1, 1), []listing.Symbol{"AAPL", "IBM", "MSFT", "NVDA"}},
+	}
+
+	for _, test := range tests {
+		if got := u.MembersOn(test.date); !slices.Equal(got, test.expected) {
+			t.Fatalf("%s: expected %v, got %v", test.date.Format(time.DateOnly), test.expected, got)
+		}
+	}
+
+	if got := u.Members(); !slices.Equal(got, []listing.Symbol{"AAPL", "IBM", "MSFT", "NVDA"}) {
+		t.Fatalf("unexpected members: %v", got)
+	}
+
+	if u.Contains("ibm", date(2021, 7, 1)) {
+		t.Fatal("IBM should not be a member in 2021-07")
+	}
+
+	if err := u.Remove(date(2021, 12, 31), "NVDA"); !errors.Is(err, ErrOutOfOrder) {
+		t.Fatalf("expected ErrOutOfOrder, got %v", err)
+	}
+}
+
+func TestSet(t *testing.T) {
+
+	u, err := FromSymbols("watch", date(2020, 1, 1), "AAPL", "MSFT")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := u.Set(date(2020, 2, 1), "MSFT", "IBM"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := u.MembersOn(date(2020, 1, 15)); !slices.Equal(got, []listing.Symbol{"AAPL", "MSFT"}) {
+		t.Fatalf("unexpected members: %v", got)
+	}
+	if got := u.MembersOn(date(2020, 2, 1)); !slices.Equal(got, []listing.Symbol{"IBM", "MSFT"}) {
+		t.Fatalf("unexpected members: %v", got)
+	}
+	if len(u.Memberships) != 3 {
+		t.Fatalf("expected 3 memberships, got %d", len(u.Memberships))
+	}
+
+	// MSFT could be removed, but AAPL was removed after the date, so the whole change fails
+	if err := u.Set(date(2020, 1, 15), "AAPL", "IBM"); !errors.Is(err, ErrOutOfOrder) {
+		t.Fatalf("expected ErrOutOfOrder, got %v", err)
+	}
+
+	if got := u.Members(); !slices.Equal(got, []listing.Symbol{"IBM", "MSFT"}) {
+		t.Fatalf("unexpected members after failed change: %v", got)
+	}
+	if len(u.Memberships) != 3 || !u.Contains("MSFT", date(2020, 1, 20)) {
+		t.Fatalf("unexpected memberships after failed change: %v", u.Memberships)
+	}
+
+	// A failed Add leaves earlier symbols unchanged
+	if err := u.Add(date(2020, 1, 20), "NVDA", "AAPL"); !errors.Is(err, ErrOutOfOrder) {
+		t.Fatalf("expected ErrOutOfOrder, got %v", err)
+	}
+	if u.Contains("NVDA", date(2020, 3, 1)) {
+		t.Fatal("NVDA should not be a member after a failed change")
+	}
+}
+
+func TestFromQuery(t *testing.T) {
+
+	f, err := os.Open("../example_data/listing_status.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+	defer f.Close()
+
+	d, err := listing.ReadSnapshot(f)
+	if err != nil {
+		t.Fatalf("failed to parse test data: %v", err)
+	}
+
+	u, err := FromQuery("aa", date(2024, 1, 1), d.Query().SymbolMatches("AA*"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	members := u.Members()
+	if len(members) == 0 {
+		t.Fatal("expected members")
+	}
+	for _, s := range members {
+		if !strings.HasPrefix(string(s), "AA") {
+			t.Fatalf("unexpected member: %s", s)
+		}
+	}
+}
+
+func TestFromQuery_1(t *testing.T) {
+
+	f, err := os.Open("../example_data/delisted_listing_status.csv")
+	if err != nil {
+		t.Fatalf("failed to read test data: %v", err)
+	}
+	defer f.Close()
+
+	d, err := listing.ReadSnapshot(f)
+	if err != nil {
+		t.Fatalf("failed to parse test data: %v", err)
+	}
+
+	// The earlier listing of the reused ACQX symbol was active on the date
+	on := date(2015, 1, 2)
+	u, err := FromQuery("pit", on, d.Query().ActiveOn(on))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := u.Members(); !slices.Equal(got, []listing.Symbol{"AABA", "ACQX", "XYZE"}) {
+		t.Fatalf("unexpected members: %v", got)
+	}
+}
+
+func TestInvalidName(t *testing.T) {
+	for _, name := range []string{"", "..", "a/b"} {
+		if _, err := New(name); !errors.Is(err, ErrInvalidName) {
+			t.Fatalf("%q: expected ErrInvalidName, got %v", name, err)
+		}
+	}
+}